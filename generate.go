@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"dbca_tui/internal/generator"
+	"dbca_tui/internal/hostprobe"
+	"dbca_tui/internal/validation"
+)
+
+// runGenerate implements the headless "generate" subcommand. It loads a
+// config file, prints the validation report to stderr and the requested
+// output to stdout. The exit code is 1 when validation finds errors.
+func runGenerate(args []string) int {
+	fs := flag.NewFlagSet("generate", flag.ContinueOnError)
+	configPath := fs.String("config", "", "Profile (.json, .yaml) or response file (.rsp) to generate from")
+	format := fs.String("format", "cmd", "Output format: cmd, rsp or script")
+	maskPasswords := fs.Bool("mask-passwords", false, "Replace passwords with <PASSWORD> in the output")
+	shell := fs.String("shell", "posix", "Shell the cmd format is quoted for: posix, cmd or powershell")
+	secrets := fs.String("secrets", string(generator.SecretsEnv), "How the script format gets passwords: env, stdin or inline")
+	fs.Usage = func() {
+		fmt.Fprintln(os.Stderr, "Usage: dbca_tui generate --config file [--format cmd|rsp|script] [--shell posix|cmd|powershell] [--secrets env|stdin|inline]")
+		fmt.Fprintln(os.Stderr, "\nOptions:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+
+	if *configPath == "" {
+		fmt.Fprintln(os.Stderr, "Error: --config is required")
+		fs.Usage()
+		return 2
+	}
+
+	switch *format {
+	case "cmd", "rsp", "script":
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown format %q (expected cmd, rsp or script)\n", *format)
+		return 2
+	}
+
+	switch generator.Shell(*shell) {
+	case generator.ShellPOSIX, generator.ShellCmd, generator.ShellPowerShell:
+	default:
+		fmt.Fprintf(os.Stderr, "Error: unknown shell %q (expected posix, cmd or powershell)\n", *shell)
+		return 2
+	}
+
+	if !generator.SecretMode(*secrets).IsValid() {
+		fmt.Fprintf(os.Stderr, "Error: unknown secret mode %q (expected env, stdin or inline)\n", *secrets)
+		return 2
+	}
+
+	config, err := loadConfigFile(*configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading %s: %v\n", *configPath, err)
+		return 1
+	}
+
+	config.ApplyCommonPassword()
+
+	// Report every finding; only errors stop generation. Output without
+	// the passwords in it does not need them, and memory settings are
+	// checked against the host generating the command.
+	host, _ := hostprobe.Probe()
+	findings := validation.Validate(config, host)
+	omitsSecrets := *maskPasswords
+	if *format == "script" {
+		omitsSecrets = generator.SecretMode(*secrets) != generator.SecretsInline
+	}
+	if omitsSecrets {
+		findings = validation.WithoutSecrets(findings)
+	}
+	for _, f := range findings {
+		fmt.Fprintln(os.Stderr, f.String())
+	}
+	if validation.HasErrors(findings) {
+		return 1
+	}
+
+	switch *format {
+	case "cmd":
+		fmt.Println(generator.RenderCommand(config, generator.Shell(*shell), *maskPasswords))
+	case "rsp":
+		if *maskPasswords {
+			fmt.Print(generator.GenerateResponseFile(config))
+		} else {
+			fmt.Print(generator.GenerateResponseFileWithPasswords(config))
+		}
+	case "script":
+		fmt.Print(generator.GenerateScript(config, generator.SecretMode(*secrets)))
+	}
+
+	return 0
+}
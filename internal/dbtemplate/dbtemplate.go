@@ -0,0 +1,212 @@
+package dbtemplate
+
+import (
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// Template describes a DBCA template file of an Oracle home
+type Template struct {
+	File                 string // File name, e.g. General_Purpose.dbc
+	Path                 string
+	Name                 string // Display name from the template
+	Description          string
+	IncludesDatafiles    bool // .dbc seed templates carry the datafiles
+	DatabaseType         model.DatabaseType
+	BlockSize            int // In bytes; 0 when not set
+	CharacterSet         string
+	NationalCharacterSet string
+	ArchiveLog           bool
+	InitParams           map[string]string // Values converted to bytes where the template gives a unit
+	Options              map[string]bool   // Database options such as JSERVER, SAMPLE_SCHEMA or DV
+}
+
+// templateXML is the part of the .dbt/.dbc format the wizard reads
+type templateXML struct {
+	Name        string `xml:"name,attr"`
+	Description string `xml:"description,attr"`
+	Options     []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+	} `xml:"CommonAttributes>option"`
+	InitParams []struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"value,attr"`
+		Unit  string `xml:"unit,attr"`
+	} `xml:"InitParamAttributes>InitParams>initParam"`
+	Misc struct {
+		DatabaseType         string `xml:"databaseType"`
+		CharacterSet         string `xml:"characterSet"`
+		NationalCharacterSet string `xml:"nationalCharacterSet"`
+		ArchiveLogMode       string `xml:"archiveLogMode"`
+	} `xml:"InitParamAttributes>MiscParams"`
+}
+
+// Dir returns the DBCA templates directory of an Oracle home
+func Dir(oracleHome string) string {
+	return filepath.Join(oracleHome, "assistants", "dbca", "templates")
+}
+
+// Scan parses the .dbt and .dbc files in the templates directory of an
+// Oracle home, sorted by file name. Files that cannot be parsed are skipped.
+func Scan(oracleHome string) ([]Template, error) {
+	dir := Dir(oracleHome)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var templates []Template
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if entry.IsDir() || (ext != ".dbt" && ext != ".dbc") {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		f, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		t, err := Parse(f)
+		f.Close()
+		if err != nil {
+			continue
+		}
+		t.File = entry.Name()
+		t.Path = path
+		t.IncludesDatafiles = ext == ".dbc"
+		templates = append(templates, *t)
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].File < templates[j].File })
+
+	return templates, nil
+}
+
+// Parse reads a DBCA template in XML form
+func Parse(r io.Reader) (*Template, error) {
+	var doc templateXML
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	t := &Template{
+		Name:                 strings.TrimSpace(doc.Name),
+		Description:          strings.TrimSpace(doc.Description),
+		DatabaseType:         model.DatabaseType(strings.ToUpper(strings.TrimSpace(doc.Misc.DatabaseType))),
+		CharacterSet:         strings.TrimSpace(doc.Misc.CharacterSet),
+		NationalCharacterSet: strings.TrimSpace(doc.Misc.NationalCharacterSet),
+		ArchiveLog:           strings.EqualFold(strings.TrimSpace(doc.Misc.ArchiveLogMode), "true"),
+		InitParams:           make(map[string]string),
+		Options:              make(map[string]bool),
+	}
+
+	for _, o := range doc.Options {
+		t.Options[strings.ToUpper(o.Name)] = strings.EqualFold(o.Value, "true")
+	}
+	for _, p := range doc.InitParams {
+		name := strings.ToLower(strings.TrimSpace(p.Name))
+		value := withUnit(strings.TrimSpace(p.Value), p.Unit)
+		t.InitParams[name] = value
+		if name == "db_block_size" {
+			t.BlockSize, _ = strconv.Atoi(value)
+		}
+	}
+
+	return t, nil
+}
+
+// withUnit converts a numeric value given in KB, MB or GB to bytes
+func withUnit(value, unit string) string {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return value
+	}
+	switch strings.ToUpper(unit) {
+	case "KB":
+		n <<= 10
+	case "MB":
+		n <<= 20
+	case "GB":
+		n <<= 30
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+// wizardFieldParams are init parameters set from wizard fields, which a
+// template value would override
+var wizardFieldParams = map[string]bool{
+	"db_create_file_dest":        true, // Storage
+	"db_recovery_file_dest_size": true, // Fast Recovery Area size
+	"enable_pluggable_database":  true, // Container database
+}
+
+// ApplyDefaults copies the template's settings onto the steps that follow
+// the template choice. It does nothing when the defaults of this template
+// were applied before, so settings changed since or loaded from a file
+// stay; the init parameters of a previously chosen template are removed
+// first. Init parameters already set or set from wizard fields are kept,
+// and values with DBCA placeholders such as {ORACLE_BASE} are left to the
+// template.
+func (t *Template) ApplyDefaults(config *model.DBConfig) {
+	if config.TemplateDefaults == t.File {
+		return
+	}
+	ClearDefaults(config)
+	config.TemplateDefaults = t.File
+
+	switch t.DatabaseType {
+	case model.DatabaseTypeMultipurpose, model.DatabaseTypeDataWarehouse, model.DatabaseTypeOLTP:
+		config.DatabaseType = t.DatabaseType
+	}
+	if t.CharacterSet != "" {
+		config.CharacterSet = t.CharacterSet
+	}
+	if t.NationalCharacterSet != "" {
+		config.NationalCharacterSet = t.NationalCharacterSet
+	}
+	config.EnableArchiveLog = t.ArchiveLog
+	if enabled, ok := t.Options["SAMPLE_SCHEMA"]; ok {
+		config.EnableSampleSchemas = enabled
+	}
+	// Data Vault and Label Security are left to their own step, which
+	// Typical mode skips and which enters the Data Vault accounts
+
+	for name, value := range t.InitParams {
+		if value == "" || strings.Contains(value, "{") || wizardFieldParams[name] {
+			continue
+		}
+		if _, known := model.LookupInitParam(name); !known {
+			continue
+		}
+		if _, set := config.InitParams[name]; set {
+			continue
+		}
+		if config.InitParams == nil {
+			config.InitParams = make(map[string]string)
+		}
+		if config.TemplateInitParams == nil {
+			config.TemplateInitParams = make(map[string]string)
+		}
+		config.InitParams[name] = value
+		config.TemplateInitParams[name] = value
+	}
+}
+
+// ClearDefaults removes the init parameters a template's defaults added,
+// except those changed since, and forgets which template they came from
+func ClearDefaults(config *model.DBConfig) {
+	for name, value := range config.TemplateInitParams {
+		if current, ok := config.InitParams[name]; ok && current == value {
+			delete(config.InitParams, name)
+		}
+	}
+	config.TemplateInitParams = nil
+	config.TemplateDefaults = ""
+}
@@ -0,0 +1,221 @@
+package dbtemplate
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"dbca_tui/internal/model"
+)
+
+const warehouse = `<?xml version = '1.0'?>
+<DatabaseTemplate name="Data Warehouse" description=" Use for data warehouses " version="19.0.0.0.0">
+   <CommonAttributes>
+      <option name="OMS" value="false"/>
+      <option name="JSERVER" value="true"/>
+      <option name="SAMPLE_SCHEMA" value="true"/>
+      <option name="DV" value="true"/>
+   </CommonAttributes>
+   <InitParamAttributes>
+      <InitParams>
+         <initParam name="db_block_size" value="32" unit="KB"/>
+         <initParam name="open_cursors" value="300"/>
+         <initParam name="processes" value="300"/>
+         <initParam name="undo_tablespace" value="UNDOTBS1"/>
+         <initParam name="audit_file_dest" value="{ORACLE_BASE}/admin/{DB_UNIQUE_NAME}/adump"/>
+         <initParam name="diagnostic_dest" value="{ORACLE_BASE}"/>
+         <initParam name="db_recovery_file_dest_size" value="10" unit="GB"/>
+         <initParam name="star_transformation_enabled" value="true"/>
+         <initParam name="remote_login_passwordfile" value=""/>
+      </InitParams>
+      <MiscParams>
+         <databaseType>DATA_WAREHOUSING</databaseType>
+         <characterSet>WE8MSWIN1252</characterSet>
+         <nationalCharacterSet>AL16UTF16</nationalCharacterSet>
+         <archiveLogMode>true</archiveLogMode>
+      </MiscParams>
+   </InitParamAttributes>
+</DatabaseTemplate>
+`
+
+const general = `<DatabaseTemplate name="General Purpose">
+   <InitParamAttributes>
+      <InitParams>
+         <initParam name="DB_BLOCK_SIZE" value="8" unit="KB"/>
+         <initParam name="open_cursors" value="300"/>
+         <initParam name="processes" value="600"/>
+      </InitParams>
+      <MiscParams>
+         <databaseType>MULTIPURPOSE</databaseType>
+      </MiscParams>
+   </InitParamAttributes>
+</DatabaseTemplate>
+`
+
+func parse(t *testing.T, file, data string) *Template {
+	t.Helper()
+	tpl, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Parse(%s) error = %v", file, err)
+	}
+	tpl.File = file
+	return tpl
+}
+
+func TestParse(t *testing.T) {
+	tpl := parse(t, "Data_Warehouse.dbc", warehouse)
+
+	if tpl.Name != "Data Warehouse" || tpl.Description != "Use for data warehouses" ||
+		tpl.DatabaseType != model.DatabaseTypeDataWarehouse || tpl.BlockSize != 32768 ||
+		tpl.CharacterSet != "WE8MSWIN1252" || tpl.NationalCharacterSet != "AL16UTF16" || !tpl.ArchiveLog {
+		t.Errorf("Parse() = %+v", tpl)
+	}
+	wantOptions := map[string]bool{"OMS": false, "JSERVER": true, "SAMPLE_SCHEMA": true, "DV": true}
+	if !maps.Equal(tpl.Options, wantOptions) {
+		t.Errorf("Parse() options = %v, want %v", tpl.Options, wantOptions)
+	}
+	if got := tpl.InitParams["db_recovery_file_dest_size"]; got != "10737418240" {
+		t.Errorf("Parse() db_recovery_file_dest_size = %s, want 10737418240", got)
+	}
+
+	// Parameter names are folded to lower case
+	if got := parse(t, "General_Purpose.dbc", general).InitParams["db_block_size"]; got != "8192" {
+		t.Errorf("Parse() db_block_size = %s, want 8192", got)
+	}
+
+	if _, err := Parse(strings.NewReader("<DatabaseTemplate>")); err == nil {
+		t.Errorf("Parse() of truncated XML succeeded")
+	}
+}
+
+func TestWithUnit(t *testing.T) {
+	tests := []struct {
+		value, unit string
+		want        string
+	}{
+		{"300", "", "300"},
+		{"8", "KB", "8192"},
+		{"8", "kb", "8192"},
+		{"512", "MB", "536870912"},
+		{"10", "GB", "10737418240"},
+		{"1", "TB", "1"},
+		{"{ORACLE_BASE}", "", "{ORACLE_BASE}"},
+		{"TRUE", "MB", "TRUE"},
+	}
+
+	for _, tt := range tests {
+		if got := withUnit(tt.value, tt.unit); got != tt.want {
+			t.Errorf("withUnit(%q, %q) = %s, want %s", tt.value, tt.unit, got, tt.want)
+		}
+	}
+}
+
+func TestApplyDefaults(t *testing.T) {
+	config := model.NewDBConfig()
+	config.InitParams["processes"] = "1000"
+	parse(t, "Data_Warehouse.dbc", warehouse).ApplyDefaults(config)
+
+	// Placeholders, wizard fields, unknown and empty values and params
+	// already set are skipped
+	want := map[string]string{
+		"db_block_size":   "32768",
+		"open_cursors":    "300",
+		"processes":       "1000",
+		"undo_tablespace": "UNDOTBS1",
+	}
+	if !maps.Equal(config.InitParams, want) {
+		t.Errorf("ApplyDefaults() init params = %v, want %v", config.InitParams, want)
+	}
+	if config.DatabaseType != model.DatabaseTypeDataWarehouse || config.CharacterSet != "WE8MSWIN1252" ||
+		!config.EnableArchiveLog || !config.EnableSampleSchemas || config.EnableDataVault {
+		t.Errorf("ApplyDefaults() = %+v", config)
+	}
+	if config.TemplateDefaults != "Data_Warehouse.dbc" {
+		t.Errorf("ApplyDefaults() recorded template %q, want Data_Warehouse.dbc", config.TemplateDefaults)
+	}
+
+	// Applying the same template again keeps the user's changes
+	config.CharacterSet = "AL32UTF8"
+	delete(config.InitParams, "undo_tablespace")
+	parse(t, "Data_Warehouse.dbc", warehouse).ApplyDefaults(config)
+	if config.CharacterSet != "AL32UTF8" || config.InitParams["undo_tablespace"] != "" {
+		t.Errorf("ApplyDefaults() of the same template reapplied it: %+v", config)
+	}
+}
+
+func TestApplyDefaultsSwitchTemplate(t *testing.T) {
+	config := model.NewDBConfig()
+	parse(t, "Data_Warehouse.dbc", warehouse).ApplyDefaults(config)
+
+	// The user edits one of the first template's params and adds another
+	config.InitParams["open_cursors"] = "500"
+	config.InitParams["nls_date_format"] = "YYYY-MM-DD"
+
+	parse(t, "General_Purpose.dbc", general).ApplyDefaults(config)
+
+	// undo_tablespace came from the first template unchanged and is removed;
+	// db_block_size and processes are replaced by the second template's values
+	want := map[string]string{
+		"db_block_size":   "8192",
+		"open_cursors":    "500",
+		"processes":       "600",
+		"nls_date_format": "YYYY-MM-DD",
+	}
+	if !maps.Equal(config.InitParams, want) {
+		t.Errorf("ApplyDefaults() after switching = %v, want %v", config.InitParams, want)
+	}
+	wantTemplate := map[string]string{"db_block_size": "8192", "processes": "600"}
+	if !maps.Equal(config.TemplateInitParams, wantTemplate) {
+		t.Errorf("TemplateInitParams = %v, want %v", config.TemplateInitParams, wantTemplate)
+	}
+	if config.DatabaseType != model.DatabaseTypeMultipurpose || config.EnableArchiveLog {
+		t.Errorf("ApplyDefaults() after switching = %+v", config)
+	}
+}
+
+func TestClearDefaults(t *testing.T) {
+	config := model.NewDBConfig()
+	parse(t, "Data_Warehouse.dbc", warehouse).ApplyDefaults(config)
+	config.InitParams["processes"] = "900"
+
+	ClearDefaults(config)
+
+	want := map[string]string{"processes": "900"}
+	if !maps.Equal(config.InitParams, want) || config.TemplateInitParams != nil || config.TemplateDefaults != "" {
+		t.Errorf("ClearDefaults() = %v, %v, %q, want %v and no template", config.InitParams, config.TemplateInitParams, config.TemplateDefaults, want)
+	}
+}
+
+func TestScan(t *testing.T) {
+	home := t.TempDir()
+	dir := Dir(home)
+	if err := os.MkdirAll(filepath.Join(dir, "sub.dbt"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range map[string]string{
+		"General_Purpose.dbc": general,
+		"Custom.dbt":          warehouse,
+		"Broken.dbt":          "<DatabaseTemplate>",
+		"README.txt":          general,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	templates, err := Scan(home)
+	if err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if len(templates) != 2 || templates[0].File != "Custom.dbt" || templates[0].IncludesDatafiles ||
+		templates[1].File != "General_Purpose.dbc" || !templates[1].IncludesDatafiles ||
+		templates[1].Path != filepath.Join(dir, "General_Purpose.dbc") {
+		t.Errorf("Scan() = %+v", templates)
+	}
+
+	if _, err := Scan(filepath.Join(home, "missing")); err == nil {
+		t.Errorf("Scan() of a home without templates succeeded")
+	}
+}
@@ -0,0 +1,222 @@
+package generator
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// Shell is the command interpreter a generated command is quoted for
+type Shell string
+
+const (
+	ShellPOSIX      Shell = "posix"      // sh, bash, ksh
+	ShellCmd        Shell = "cmd"        // .cmd and .bat scripts run by cmd.exe
+	ShellPowerShell Shell = "powershell" // Windows PowerShell and pwsh
+)
+
+// maskedPassword replaces passwords in previews
+const maskedPassword = "<PASSWORD>"
+
+// secretStyle selects how password values are rendered
+type secretStyle int
+
+const (
+	secretsActual   secretStyle = iota
+	secretsMasked               // <PASSWORD>
+	secretsVariable             // a reference to the shell variable named by the option
+)
+
+// arg is one option of a dbca command line. Values are kept unquoted
+// until the command is rendered for a shell.
+type arg struct {
+	name     string // e.g. -sourceDB
+	value    string
+	hasValue bool   // false for switches such as -silent
+	secret   string // variable holding the password; empty for other values
+}
+
+// command is a dbca invocation built as a typed argument list
+type command struct {
+	oracleHome string // empty runs dbca from the PATH
+	args       []arg
+}
+
+// newCommand starts a silent dbca command for an operation flag
+func newCommand(oracleHome, operation string) *command {
+	c := &command{oracleHome: oracleHome}
+	c.flag("-silent")
+	c.flag(operation)
+	return c
+}
+
+// flag adds an option without a value
+func (c *command) flag(name string) {
+	c.args = append(c.args, arg{name: name})
+}
+
+// option adds an option with a value
+func (c *command) option(name, value string) {
+	c.args = append(c.args, arg{name: name, value: value, hasValue: true})
+}
+
+// optionf adds an option with a formatted value
+func (c *command) optionf(name, format string, a ...any) {
+	c.option(name, fmt.Sprintf(format, a...))
+}
+
+// secret adds an option whose value is a password. Scripts that keep
+// passwords out of the file read it from the named variable instead.
+func (c *command) secret(name, variable, value string) {
+	c.args = append(c.args, arg{name: name, value: value, hasValue: true, secret: variable})
+}
+
+// add appends options built by a helper
+func (c *command) add(args ...arg) {
+	c.args = append(c.args, args...)
+}
+
+// secretVariables returns the variables of the password options, in
+// command order and without duplicates
+func (c *command) secretVariables() []string {
+	var variables []string
+	for _, a := range c.args {
+		if a.secret != "" && !slices.Contains(variables, a.secret) {
+			variables = append(variables, a.secret)
+		}
+	}
+	return variables
+}
+
+// render quotes every value for the shell and puts each option on its own
+// continued line
+func (c *command) render(shell Shell, secrets secretStyle) string {
+	quote, continuation := QuotePOSIX, " \\\n  "
+	switch shell {
+	case ShellCmd:
+		quote, continuation = quoteCmd, " ^\n  "
+	case ShellPowerShell:
+		quote, continuation = quotePowerShell, " `\n  "
+	}
+
+	words := []string{c.program(shell)}
+	for _, a := range c.args {
+		if !a.hasValue {
+			words = append(words, a.name)
+			continue
+		}
+		switch {
+		case a.secret != "" && secrets == secretsMasked:
+			words = append(words, a.name+" "+quote(maskedPassword))
+		case a.secret != "" && secrets == secretsVariable:
+			words = append(words, a.name+" "+variableReference(shell, a.secret))
+		default:
+			words = append(words, a.name+" "+quote(a.value))
+		}
+	}
+
+	return strings.Join(words, continuation)
+}
+
+// program returns the dbca executable of the Oracle home, or dbca from the
+// PATH when no home was selected. Windows homes run bin\dbca.bat.
+func (c *command) program(shell Shell) string {
+	if c.oracleHome == "" {
+		return "dbca"
+	}
+
+	switch shell {
+	case ShellCmd:
+		return quoteCmd(windowsDBCAPath(c.oracleHome))
+	case ShellPowerShell:
+		// A quoted path is a string to PowerShell; & runs it
+		exe := windowsDBCAPath(c.oracleHome)
+		if quoted := quotePowerShell(exe); quoted != exe {
+			return "& " + quoted
+		}
+		return exe
+	default:
+		return QuotePOSIX(path.Join(c.oracleHome, "bin", "dbca"))
+	}
+}
+
+// variableReference expands a variable as a single argument
+func variableReference(shell Shell, name string) string {
+	switch shell {
+	case ShellCmd:
+		return `"%` + name + `%"`
+	case ShellPowerShell:
+		return "$env:" + name
+	default:
+		return `"$` + name + `"`
+	}
+}
+
+// windowsDBCAPath returns the dbca batch file of a Windows Oracle home
+func windowsDBCAPath(oracleHome string) string {
+	return strings.TrimRight(oracleHome, `\/`) + `\bin\dbca.bat`
+}
+
+// QuotePOSIX returns a value unchanged when a POSIX shell reads it as a
+// single literal word, and wrapped in single quotes otherwise. Nothing is
+// special inside single quotes, so an embedded quote closes the string,
+// adds an escaped quote and reopens it.
+func QuotePOSIX(value string) string {
+	if isPlainWord(value, "_@%+=:,./-") {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+// quoteCmd quotes a value for cmd.exe and batch files. Inside double
+// quotes the & | < > ^ metacharacters are literal; a double quote is
+// doubled and % is doubled so variable expansion in a .cmd script leaves
+// it alone. Line breaks cannot be passed through cmd.exe at all.
+func quoteCmd(value string) string {
+	if isPlainWord(value, `_@+:./\-`) {
+		return value
+	}
+	value = strings.ReplaceAll(value, `"`, `""`)
+	value = strings.ReplaceAll(value, "%", "%%")
+	return `"` + value + `"`
+}
+
+// quotePowerShell returns a value unchanged when PowerShell reads it as a
+// bare literal, and as a single-quoted string otherwise. PowerShell also
+// treats the typographic single quotes as quote characters, so those are
+// doubled along with the ASCII one. Before PowerShell 7.3, double quotes
+// inside a value are not passed to native programs intact.
+func quotePowerShell(value string) string {
+	if isPlainWord(value, `_:./\-`) && !strings.HasPrefix(value, "-") {
+		return value
+	}
+	var b strings.Builder
+	b.WriteByte('\'')
+	for _, r := range value {
+		switch r {
+		case '\'', '‘', '’', '‚', '‛':
+			b.WriteRune(r)
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('\'')
+	return b.String()
+}
+
+// isPlainWord returns true for a non-empty value made of ASCII letters,
+// digits and the given punctuation only
+func isPlainWord(value, punctuation string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case strings.ContainsRune(punctuation, r):
+		default:
+			return false
+		}
+	}
+	return true
+}
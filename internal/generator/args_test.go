@@ -0,0 +1,118 @@
+package generator
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+// FuzzQuotePOSIX checks that sh reads every quoted value back unchanged
+func FuzzQuotePOSIX(f *testing.F) {
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		f.Skip("sh is not available")
+	}
+
+	for _, seed := range []string{
+		"", "plain", "with space", "it's", `"double"`, "$HOME", "$(id)", "`id`",
+		`back\slash`, "semi;colon", "a&b|c", "glob*?[x]", "~user", "#comment",
+		"new\nline", "tab\there", "!bang", "100%", "^caret", "ünïcødé", "-n",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		// Arguments cannot carry NUL bytes
+		if strings.ContainsRune(value, 0) {
+			t.Skip()
+		}
+
+		out, err := exec.Command(sh, "-c", "printf %s "+QuotePOSIX(value)).Output()
+		if err != nil {
+			t.Fatalf("sh failed on %q quoted as %s: %v", value, QuotePOSIX(value), err)
+		}
+		if string(out) != value {
+			t.Fatalf("sh read %q quoted as %s back as %q", value, QuotePOSIX(value), out)
+		}
+	})
+}
+
+func TestQuoteCmd(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"plain", "plain"},
+		{`C:\app\oracle`, `C:\app\oracle`},
+		{"", `""`},
+		{"with space", `"with space"`},
+		{"100%", `"100%%"`},
+		{"%PATH%", `"%%PATH%%"`},
+		{"bang!", `"bang!"`},
+		{"^caret", `"^caret"`},
+		{`say "hi"`, `"say ""hi"""`},
+		{"back`tick", "\"back`tick\""},
+		{"$dollar", `"$dollar"`},
+		{"it's", `"it's"`},
+		{"a&b|c<d>e", `"a&b|c<d>e"`},
+	}
+
+	for _, tt := range tests {
+		if got := quoteCmd(tt.value); got != tt.want {
+			t.Errorf("quoteCmd(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestQuotePowerShell(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"plain", "plain"},
+		{`C:\app\oracle`, `C:\app\oracle`},
+		{"", "''"},
+		{"-leading", "'-leading'"},
+		{"with space", "'with space'"},
+		{"100%", "'100%'"},
+		{"bang!", "'bang!'"},
+		{"^caret", "'^caret'"},
+		{`say "hi"`, `'say "hi"'`},
+		{"back`tick", "'back`tick'"},
+		{"$env:PATH", "'$env:PATH'"},
+		{"it's", "'it''s'"},
+		{"it’s", "'it’’s'"},
+		{"a;b|c", "'a;b|c'"},
+	}
+
+	for _, tt := range tests {
+		if got := quotePowerShell(tt.value); got != tt.want {
+			t.Errorf("quotePowerShell(%q) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestRenderSecrets(t *testing.T) {
+	c := &command{}
+	c.secret("-sysPassword", "SYS_PASSWORD", `p%a"s$s'w!`)
+
+	tests := []struct {
+		shell   Shell
+		secrets secretStyle
+		want    string
+	}{
+		{ShellPOSIX, secretsActual, `dbca \` + "\n  " + `-sysPassword 'p%a"s$s'\''w!'`},
+		{ShellPOSIX, secretsVariable, `dbca \` + "\n  " + `-sysPassword "$SYS_PASSWORD"`},
+		{ShellCmd, secretsActual, "dbca ^\n  " + `-sysPassword "p%%a""s$s'w!"`},
+		{ShellCmd, secretsVariable, "dbca ^\n  " + `-sysPassword "%SYS_PASSWORD%"`},
+		{ShellPowerShell, secretsActual, "dbca `\n  " + `-sysPassword 'p%a"s$s''w!'`},
+		{ShellPowerShell, secretsVariable, "dbca `\n  " + `-sysPassword $env:SYS_PASSWORD`},
+		{ShellPOSIX, secretsMasked, `dbca \` + "\n  " + `-sysPassword '<PASSWORD>'`},
+	}
+
+	for _, tt := range tests {
+		if got := c.render(tt.shell, tt.secrets); got != tt.want {
+			t.Errorf("render(%s, %d) = %q, want %q", tt.shell, tt.secrets, got, tt.want)
+		}
+	}
+}
@@ -1,182 +1,283 @@
 package generator
 
 import (
-	"fmt"
-	"strings"
-
 	"dbca_tui/internal/model"
 )
 
 // GenerateCommand generates the DBCA silent mode command (with masked passwords)
 func GenerateCommand(config *model.DBConfig) string {
-	if config.Operation == model.OperationDelete {
-		return generateDeleteCommand(config, true)
-	}
-	return generateCreateCommand(config, true)
+	return generateCommand(config, true)
 }
 
 // GenerateCommandWithPasswords generates the command with actual passwords
 func GenerateCommandWithPasswords(config *model.DBConfig) string {
-	if config.Operation == model.OperationDelete {
-		return generateDeleteCommand(config, false)
+	return generateCommand(config, false)
+}
+
+// RenderCommand renders the DBCA command quoted for a shell, with masked or actual passwords
+func RenderCommand(config *model.DBConfig, shell Shell, maskPwd bool) string {
+	secrets := secretsActual
+	if maskPwd {
+		secrets = secretsMasked
 	}
-	return generateCreateCommand(config, false)
+	return buildCommand(config).render(shell, secrets)
 }
 
-// generateDeleteCommand generates the DBCA delete command
-func generateDeleteCommand(config *model.DBConfig, maskPwd bool) string {
-	var args []string
+// generateCommand renders the DBCA command for a POSIX shell
+func generateCommand(config *model.DBConfig, maskPwd bool) string {
+	return RenderCommand(config, ShellPOSIX, maskPwd)
+}
 
-	args = append(args, "dbca", "-silent", "-deleteDatabase")
+// buildCommand dispatches to the builder of the configured operation
+func buildCommand(config *model.DBConfig) *command {
+	switch config.Operation {
+	case model.OperationDelete:
+		return buildDeleteCommand(config)
+	case model.OperationCreatePDB:
+		return buildCreatePDBCommand(config)
+	case model.OperationDeletePDB:
+		return buildDeletePDBCommand(config)
+	case model.OperationUnplugPDB:
+		return buildUnplugPDBCommand(config)
+	case model.OperationPlugPDB:
+		return buildPlugPDBCommand(config)
+	case model.OperationRelocatePDB:
+		return buildRelocatePDBCommand(config)
+	case model.OperationConfigure:
+		return buildConfigureCommand(config)
+	case model.OperationTemplate:
+		return buildTemplateCommand(config)
+	case model.OperationDuplicate:
+		return buildDuplicateCommand(config)
+	case model.OperationAddInstance, model.OperationDeleteInstance:
+		return buildInstanceCommand(config)
+	default:
+		return buildCreateCommand(config)
+	}
+}
+
+// OperationTitle returns a short human readable name for the configured operation
+func OperationTitle(config *model.DBConfig) string {
+	switch config.Operation {
+	case model.OperationDelete:
+		return "Delete Database"
+	case model.OperationCreatePDB:
+		return "Create Pluggable Database"
+	case model.OperationDeletePDB:
+		return "Delete Pluggable Database"
+	case model.OperationUnplugPDB:
+		return "Unplug Pluggable Database"
+	case model.OperationPlugPDB:
+		return "Plug Pluggable Database"
+	case model.OperationRelocatePDB:
+		return "Relocate Pluggable Database"
+	case model.OperationConfigure:
+		return "Configure Database"
+	case model.OperationTemplate:
+		return "Create Template"
+	case model.OperationDuplicate:
+		if config.CreateAsStandby {
+			return "Create Standby Database"
+		}
+		return "Duplicate Database"
+	case model.OperationAddInstance:
+		return "Add Instance"
+	case model.OperationDeleteInstance:
+		return "Delete Instance"
+	default:
+		return "Create Database"
+	}
+}
+
+// operationFlag returns the DBCA flag selecting the configured operation
+func operationFlag(config *model.DBConfig) string {
+	switch config.Operation {
+	case model.OperationDelete:
+		return "-deleteDatabase"
+	case model.OperationCreatePDB:
+		return "-createPluggableDatabase"
+	case model.OperationDeletePDB:
+		return "-deletePluggableDatabase"
+	case model.OperationUnplugPDB:
+		return "-unplugDatabase"
+	case model.OperationPlugPDB:
+		return "-plugDatabase"
+	case model.OperationRelocatePDB:
+		return "-relocatePDB"
+	case model.OperationConfigure:
+		return "-configureDatabase"
+	case model.OperationTemplate:
+		if config.TemplateIncludeDatafiles {
+			return "-createCloneTemplate"
+		}
+		return "-createTemplateFromDB"
+	case model.OperationDuplicate:
+		return "-createDuplicateDB"
+	case model.OperationAddInstance:
+		return "-addInstance"
+	case model.OperationDeleteInstance:
+		return "-deleteInstance"
+	default:
+		return "-createDatabase"
+	}
+}
+
+// buildDeleteCommand builds the DBCA delete command
+func buildDeleteCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-deleteDatabase")
 
 	// Database SID
-	args = append(args, fmt.Sprintf("-sourceDB %s", config.DeleteSID))
+	c.option("-sourceDB", config.DeleteSID)
 
-	// SYS password
-	pwd := config.SysPassword
-	if maskPwd {
-		pwd = "<PASSWORD>"
+	// SYS credentials
+	c.option("-sysDBAUserName", "SYS")
+	if config.UsesWallet() {
+		c.add(walletArgs(config)...)
+	} else {
+		c.secret("-sysDBAPassword", "SYS_PASSWORD", config.SysPassword)
 	}
-	args = append(args, "-sysDBAUserName SYS")
-	args = append(args, fmt.Sprintf("-sysDBAPassword '%s'", pwd))
 
 	// Force delete option
 	if config.DeleteForce {
-		args = append(args, "-forceArchiveLogDeletion")
+		c.flag("-forceArchiveLogDeletion")
 	}
 
-	return strings.Join(args, " \\\n  ")
+	return c
 }
 
-// generateCreateCommand generates the DBCA create command
-func generateCreateCommand(config *model.DBConfig, maskPwd bool) string {
-	var args []string
-
-	args = append(args, "dbca", "-silent", "-createDatabase")
+// buildCreateCommand builds the DBCA create command
+func buildCreateCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-createDatabase")
 
 	// Template
-	if config.TemplateName != model.TemplateCustom {
-		args = append(args, fmt.Sprintf("-templateName %s", config.TemplateName))
+	if config.TemplateName.IsFile() {
+		c.option("-templateName", string(config.TemplateName))
+	} else if config.TemplateName != model.TemplateCustom {
+		c.option("-templateName", config.TargetVersion.TemplateFile(config.TemplateName))
 	}
 
 	// Database identification
-	args = append(args, fmt.Sprintf("-gdbname %s", config.GlobalDBName))
-	args = append(args, fmt.Sprintf("-sid %s", config.SID))
+	c.option("-gdbname", config.GlobalDBName)
+	c.option("-sid", config.SID)
 
 	// Container database settings
 	if config.CreateAsContainerDB {
-		args = append(args, "-createAsContainerDatabase true")
+		c.option("-createAsContainerDatabase", "true")
+		c.optionf("-useLocalUndoForPDBs", "%t", config.UseLocalUndoForPDBs)
 		if config.NumberOfPDBs > 0 {
-			args = append(args, fmt.Sprintf("-numberOfPDBs %d", config.NumberOfPDBs))
-			args = append(args, fmt.Sprintf("-pdbName %s", config.PDBName))
-			pdbPwd := config.PDBAdminPassword
-			if maskPwd {
-				pdbPwd = "<PASSWORD>"
-			}
-			args = append(args, fmt.Sprintf("-pdbAdminPassword '%s'", pdbPwd))
+			c.optionf("-numberOfPDBs", "%d", config.NumberOfPDBs)
+			c.option("-pdbName", config.PDBName)
+			c.secret("-pdbAdminPassword", "PDB_ADMIN_PASSWORD", config.PDBAdminPassword)
 		}
 	} else {
-		args = append(args, "-createAsContainerDatabase false")
+		c.option("-createAsContainerDatabase", "false")
 	}
 
-	// Passwords
-	sysPwd := config.SysPassword
-	systemPwd := config.SystemPassword
-	if maskPwd {
-		sysPwd = "<PASSWORD>"
-		systemPwd = "<PASSWORD>"
+	// Passwords, or the wallet holding them
+	if config.UsesWallet() {
+		c.add(walletArgs(config)...)
+	} else {
+		c.secret("-sysPassword", "SYS_PASSWORD", config.SysPassword)
+		c.secret("-systemPassword", "SYSTEM_PASSWORD", config.SystemPassword)
 	}
-	args = append(args, fmt.Sprintf("-sysPassword '%s'", sysPwd))
-	args = append(args, fmt.Sprintf("-systemPassword '%s'", systemPwd))
 
 	// Character set
-	args = append(args, fmt.Sprintf("-characterSet %s", config.CharacterSet))
-	args = append(args, fmt.Sprintf("-nationalCharacterSet %s", config.NationalCharacterSet))
+	c.option("-characterSet", config.CharacterSet)
+	c.option("-nationalCharacterSet", config.NationalCharacterSet)
 
 	// Memory configuration
-	args = append(args, fmt.Sprintf("-totalMemory %d", config.TotalMemory))
-	switch config.MemoryManagement {
-	case "AUTO":
-		args = append(args, "-memoryMgmtType AUTO")
-	case "AUTO_SGA":
-		args = append(args, "-memoryMgmtType AUTO_SGA")
-	default:
-		args = append(args, "-memoryMgmtType CUSTOM")
-	}
+	c.optionf("-totalMemory", "%d", config.TotalMemory)
+	c.option("-memoryMgmtType", memoryMgmtType(config))
 
 	// Database type
-	args = append(args, fmt.Sprintf("-databaseType %s", config.DatabaseType))
+	c.option("-databaseType", string(config.DatabaseType))
 
 	// Storage configuration
-	args = append(args, fmt.Sprintf("-storageType %s", config.StorageType))
+	c.option("-storageType", string(config.StorageType))
 
 	if config.StorageType == model.StorageTypeASM {
-		args = append(args, fmt.Sprintf("-diskGroupName %s", config.ASMDiskGroup))
+		c.option("-diskGroupName", config.ASMDiskGroup)
 	} else {
-		args = append(args, fmt.Sprintf("-datafileDestination '%s'", config.DatafileDestination))
+		c.option("-datafileDestination", config.DatafileDestination)
 	}
 
 	// Use OMF
 	if config.UseOMF {
-		args = append(args, "-useOMF true")
+		c.option("-useOMF", "true")
 	}
 
 	// Fast Recovery Area
 	if config.EnableFRA {
-		args = append(args, fmt.Sprintf("-recoveryAreaDestination '%s'", config.FRADestination))
-		args = append(args, fmt.Sprintf("-recoveryAreaSize %d", config.FRASize))
+		c.option("-recoveryAreaDestination", config.FRADestination)
+		c.optionf("-recoveryAreaSize", "%d", config.FRASize)
 	}
 
 	// Redo log size
 	if config.RedoLogFileSize > 0 {
-		args = append(args, fmt.Sprintf("-redoLogFileSize %d", config.RedoLogFileSize))
+		c.optionf("-redoLogFileSize", "%d", config.RedoLogFileSize)
 	}
 
 	// Listener configuration
 	if config.ListenerName != "" && config.ListenerName != "LISTENER" {
-		args = append(args, fmt.Sprintf("-listeners %s", config.ListenerName))
+		c.option("-listeners", config.ListenerName)
 	}
 
 	// Enterprise Manager configuration
-	args = append(args, fmt.Sprintf("-emConfiguration %s", config.EMConfiguration))
+	c.option("-emConfiguration", string(config.EMConfiguration))
 	if config.EMConfiguration == model.EMConfigDBExpress {
-		args = append(args, fmt.Sprintf("-dbExpressPort %d", config.EMPort))
+		c.optionf("-dbExpressPort", "%d", config.EMPort)
 	}
 
 	// Sample schemas
 	if config.EnableSampleSchemas {
-		args = append(args, "-sampleSchema true")
+		c.option("-sampleSchema", "true")
 	}
 
 	// Archive log mode
 	if config.EnableArchiveLog {
-		args = append(args, "-archiveLogMode true")
+		c.option("-archiveLogMode", "true")
 	}
 
 	// Data Vault
 	if config.EnableDataVault {
-		args = append(args, "-enableDV true")
-		args = append(args, fmt.Sprintf("-dvOwnerName %s", config.DataVaultOwner))
-		args = append(args, fmt.Sprintf("-dvAccountManagerName %s", config.DataVaultAccountManager))
+		c.option("-enableDV", "true")
+		c.option("-dvOwnerName", config.DataVaultOwner)
+		c.option("-dvAccountManagerName", config.DataVaultAccountManager)
+	}
+
+	// Label Security
+	if config.EnableLabelSecurity {
+		c.option("-olsConfiguration", "true")
 	}
 
 	// RAC-specific options
 	switch config.DeploymentType {
 	case model.DeploymentRAC:
-		args = append(args, "-databaseConfigType RAC")
+		c.option("-databaseConfigType", "RAC")
 		if config.NodeList != "" {
-			args = append(args, fmt.Sprintf("-nodelist %s", config.NodeList))
+			c.option("-nodelist", config.NodeList)
 		}
 	case model.DeploymentRACOneNode:
-		args = append(args, "-databaseConfigType RACONENODE")
+		c.option("-databaseConfigType", "RACONENODE")
 	default:
-		args = append(args, "-databaseConfigType SI")
+		c.option("-databaseConfigType", "SI")
+	}
+
+	// Custom and memory sizing initialization parameters
+	if initParams := allInitParams(config); len(initParams) > 0 {
+		c.option("-initParams", joinInitParams(initParams))
 	}
 
 	// Ignore prerequisites
 	if config.IgnorePreReqs {
-		args = append(args, "-ignorePreReqs")
+		c.flag("-ignorePreReqs")
 	}
 
-	return strings.Join(args, " \\\n  ")
+	return c
+}
+
+// dbcaPath returns the dbca executable of the selected Oracle home, or
+// dbca from the PATH when no home was selected
+func dbcaPath(config *model.DBConfig) string {
+	return (&command{oracleHome: config.OracleHome}).program(ShellPOSIX)
 }
@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"strconv"
+
+	"dbca_tui/internal/model"
+)
+
+// buildConfigureCommand builds the DBCA configure database command.
+// Only the options that differ from the current state of the database are emitted.
+func buildConfigureCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-configureDatabase")
+	c.option("-sourceDB", config.ConfigureSID)
+	c.add(sysDBAArgs(config)...)
+
+	baseline := config.Baseline()
+	options := config.Options()
+
+	// Archive log mode
+	if options.EnableArchiveLog && !baseline.EnableArchiveLog {
+		c.option("-enableArchive", "true")
+	}
+
+	// Enterprise Manager configuration
+	if options.EMChanged(baseline) {
+		c.option("-emConfiguration", string(config.EMConfiguration))
+		switch config.EMConfiguration {
+		case model.EMConfigDBExpress:
+			c.optionf("-emExpressPort", "%d", config.EMPort)
+		case model.EMConfigCentral:
+			c.option("-omsHost", config.CloudControlAgent)
+			c.optionf("-omsPort", "%d", config.EMPort)
+		}
+	}
+
+	// Data Vault
+	if options.EnableDataVault && !baseline.EnableDataVault {
+		c.option("-dvConfiguration", "true")
+		c.option("-dvUserName", config.DataVaultOwner)
+		c.secret("-dvUserPassword", "DV_OWNER_PASSWORD", config.DataVaultOwnerPassword)
+		c.option("-dvAccountManagerName", config.DataVaultAccountManager)
+		c.secret("-dvAccountManagerPassword", "DV_ACCOUNT_MANAGER_PASSWORD", config.DataVaultAccountManagerPassword)
+	}
+
+	// Label Security
+	if options.EnableLabelSecurity && !baseline.EnableLabelSecurity {
+		c.option("-olsConfiguration", "true")
+	}
+
+	return c
+}
+
+// configureResponseEntries maps the changed configure options to response file keys
+func configureResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	var entries []rspEntry
+	add := func(key, value string) {
+		entries = append(entries, rspEntry{key, value})
+	}
+
+	add("responseFileVersion", config.TargetVersion.ResponseFileSchema())
+	add("sourceDB", config.ConfigureSID)
+	entries = append(entries, sysDBAResponseEntries(config, maskPwd)...)
+
+	baseline := config.Baseline()
+	options := config.Options()
+
+	if options.EnableArchiveLog && !baseline.EnableArchiveLog {
+		add("enableArchive", "true")
+	}
+
+	if options.EMChanged(baseline) {
+		add("emConfiguration", string(config.EMConfiguration))
+		switch config.EMConfiguration {
+		case model.EMConfigDBExpress:
+			add("emExpressPort", strconv.Itoa(config.EMPort))
+		case model.EMConfigCentral:
+			add("omsHost", config.CloudControlAgent)
+			add("omsPort", strconv.Itoa(config.EMPort))
+		}
+	}
+
+	if options.EnableDataVault && !baseline.EnableDataVault {
+		add("dvConfiguration", "true")
+		add("dvUserName", config.DataVaultOwner)
+		add("dvUserPassword", password(config.DataVaultOwnerPassword, maskPwd))
+		add("dvAccountManagerName", config.DataVaultAccountManager)
+		add("dvAccountManagerPassword", password(config.DataVaultAccountManagerPassword, maskPwd))
+	}
+
+	if options.EnableLabelSecurity && !baseline.EnableLabelSecurity {
+		add("olsConfiguration", "true")
+	}
+
+	return entries
+}
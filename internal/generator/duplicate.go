@@ -0,0 +1,68 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+
+	"dbca_tui/internal/model"
+)
+
+// buildDuplicateCommand builds the DBCA command duplicating a primary
+// database, optionally as a physical standby
+func buildDuplicateCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-createDuplicateDB")
+
+	// Database identification and primary
+	c.option("-gdbName", config.GlobalDBName)
+	c.option("-sid", config.SID)
+	c.option("-primaryDBConnectionString", config.PrimaryConnectString)
+	if config.CreateAsStandby {
+		c.flag("-createAsStandby")
+		c.option("-dbUniqueName", config.StandbyUniqueName)
+	}
+	c.secret("-sysPassword", "SYS_PASSWORD", config.SysPassword)
+
+	// Storage configuration; ASM takes the disk group as the destination
+	c.option("-storageType", string(config.StorageType))
+	c.option("-datafileDestination", config.DatafileDestination)
+	if config.UseOMF {
+		c.option("-useOMF", "true")
+	}
+
+	// Listener the duplicate registers with
+	if config.CreateNewListener {
+		c.optionf("-createListener", "%s:%d", config.ListenerName, config.ListenerPort)
+	} else {
+		c.option("-listeners", config.ListenerName)
+	}
+
+	return c
+}
+
+// duplicateResponseEntries maps the duplicate database options to response file keys
+func duplicateResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	var entries []rspEntry
+	add := func(key, value string) {
+		entries = append(entries, rspEntry{key, value})
+	}
+
+	add("responseFileVersion", config.TargetVersion.ResponseFileSchema())
+	add("gdbName", config.GlobalDBName)
+	add("sid", config.SID)
+	add("primaryDBConnectionString", config.PrimaryConnectString)
+	add("createAsStandby", strconv.FormatBool(config.CreateAsStandby))
+	if config.CreateAsStandby {
+		add("dbUniqueName", config.StandbyUniqueName)
+	}
+	add("sysPassword", password(config.SysPassword, maskPwd))
+	add("storageType", string(config.StorageType))
+	add("datafileDestination", config.DatafileDestination)
+	add("useOMF", strconv.FormatBool(config.UseOMF))
+	if config.CreateNewListener {
+		add("createListener", fmt.Sprintf("%s:%d", config.ListenerName, config.ListenerPort))
+	} else {
+		add("listeners", config.ListenerName)
+	}
+
+	return entries
+}
@@ -0,0 +1,33 @@
+package generator
+
+import (
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// buildInstanceCommand builds the DBCA command adding an instance to,
+// or deleting one from, a RAC database
+func buildInstanceCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, operationFlag(config))
+	c.option("-gdbName", config.GlobalDBName)
+	c.option("-nodeName", config.InstanceNode)
+	if config.InstanceName != "" {
+		c.option("-instanceName", config.InstanceName)
+	}
+	c.add(sysDBAArgs(config)...)
+
+	return c
+}
+
+// instanceResponseEntries maps the add and delete instance options to response file keys
+func instanceResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	entries := []rspEntry{
+		{"responseFileVersion", config.TargetVersion.ResponseFileSchema()},
+		{"operationType", strings.TrimPrefix(operationFlag(config), "-")},
+		{"gdbName", config.GlobalDBName},
+		{"nodeName", config.InstanceNode},
+		{"instanceName", config.InstanceName},
+	}
+	return append(entries, sysDBAResponseEntries(config, maskPwd)...)
+}
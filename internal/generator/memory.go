@@ -0,0 +1,56 @@
+package generator
+
+import (
+	"strconv"
+
+	"dbca_tui/internal/model"
+)
+
+// memoryMgmtType maps the wizard memory mode to the DBCA -memoryMgmtType value
+func memoryMgmtType(config *model.DBConfig) string {
+	switch config.MemoryManagement {
+	case "AUTO":
+		return "AUTO"
+	case "AUTO_SGA":
+		return "AUTO_SGA"
+	default:
+		return "CUSTOM_SGA"
+	}
+}
+
+// memoryInitParams returns the SGA/PGA init parameters for AUTO_SGA and
+// MANUAL memory management. AUTO leaves sizing to memory_target.
+func memoryInitParams(config *model.DBConfig) map[string]string {
+	params := make(map[string]string)
+	megabytes := func(mb int) string {
+		return strconv.Itoa(mb) + "M"
+	}
+
+	switch config.MemoryManagement {
+	case "AUTO_SGA":
+		params["sga_target"] = megabytes(config.SGASize)
+	case "MANUAL":
+		params["sga_max_size"] = megabytes(config.SGASize)
+		// An unset target leaves the SGA components sized manually
+		if config.SGATarget > 0 {
+			params["sga_target"] = megabytes(config.SGATarget)
+		}
+	default:
+		return params
+	}
+
+	params["pga_aggregate_target"] = megabytes(config.PGASize)
+	if config.PGAAggregateLimit > 0 {
+		params["pga_aggregate_limit"] = megabytes(config.PGAAggregateLimit)
+	}
+	return params
+}
+
+// allInitParams merges the custom init parameters with the memory sizing ones
+func allInitParams(config *model.DBConfig) map[string]string {
+	params := memoryInitParams(config)
+	for name, value := range config.InitParams {
+		params[name] = value
+	}
+	return params
+}
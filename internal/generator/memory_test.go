@@ -0,0 +1,48 @@
+package generator
+
+import (
+	"maps"
+	"testing"
+
+	"dbca_tui/internal/model"
+)
+
+func TestMemoryInitParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		config model.DBConfig
+		want   map[string]string
+	}{
+		{
+			name:   "AUTO leaves sizing to memory_target",
+			config: model.DBConfig{MemoryManagement: "AUTO", SGASize: 1024, PGASize: 512},
+			want:   map[string]string{},
+		},
+		{
+			name:   "AUTO_SGA",
+			config: model.DBConfig{MemoryManagement: "AUTO_SGA", SGASize: 1536, PGASize: 512},
+			want:   map[string]string{"sga_target": "1536M", "pga_aggregate_target": "512M"},
+		},
+		{
+			name:   "MANUAL without a target",
+			config: model.DBConfig{MemoryManagement: "MANUAL", SGASize: 2048, PGASize: 512},
+			want:   map[string]string{"sga_max_size": "2048M", "pga_aggregate_target": "512M"},
+		},
+		{
+			name:   "MANUAL with a target and a PGA limit",
+			config: model.DBConfig{MemoryManagement: "MANUAL", SGASize: 2048, SGATarget: 1024, PGASize: 512, PGAAggregateLimit: 1024},
+			want: map[string]string{
+				"sga_max_size":         "2048M",
+				"sga_target":           "1024M",
+				"pga_aggregate_target": "512M",
+				"pga_aggregate_limit":  "1024M",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		if got := memoryInitParams(&tt.config); !maps.Equal(got, tt.want) {
+			t.Errorf("%s: memoryInitParams() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// buildCreatePDBCommand builds the DBCA create pluggable database command
+func buildCreatePDBCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-createPluggableDatabase")
+
+	// Container database and new PDB
+	c.option("-sourceDB", config.SourceCDB)
+	c.option("-pdbName", config.NewPDBName)
+
+	// PDB source
+	switch config.PDBSource {
+	case model.PDBSourceClone:
+		c.option("-sourcePDB", config.SourcePDB)
+	case model.PDBSourceXML:
+		c.option("-createPDBFrom", "USINGXML")
+		c.option("-PDBMetadataFile", config.PDBMetadataFile)
+	default:
+		c.option("-createPDBFrom", "DEFAULT")
+	}
+
+	// Datafile placement
+	if config.PDBUseOMF {
+		if config.PDBDatafileDestination != "" {
+			c.option("-pdbDatafileDestination", config.PDBDatafileDestination)
+		}
+	} else if config.PDBFileNameConvert != "" {
+		c.option("-fileNameConvert", fileNameConvert(config))
+	} else if config.PDBSource == model.PDBSourceXML {
+		c.option("-useMetaDataFileLocation", "true")
+	}
+
+	// A PDB created from the seed gets a new local administrator
+	if config.PDBSource == model.PDBSourceSeed {
+		c.option("-createNewPDBAdminUser", "true")
+		c.option("-pdbAdminUserName", config.PDBAdminUser)
+		c.secret("-pdbAdminPassword", "PDB_ADMIN_PASSWORD", config.PDBAdminPassword)
+	}
+
+	return c
+}
+
+// buildDeletePDBCommand builds the DBCA delete pluggable database command
+func buildDeletePDBCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-deletePluggableDatabase")
+	c.option("-sourceDB", config.SourceCDB)
+	c.option("-pdbName", config.TargetPDB)
+	c.add(sysDBAArgs(config)...)
+
+	return c
+}
+
+// buildUnplugPDBCommand builds the DBCA unplug pluggable database command
+func buildUnplugPDBCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-unplugDatabase")
+	c.option("-sourceDB", config.SourceCDB)
+	c.option("-pdbName", config.TargetPDB)
+	c.option("-archiveType", string(config.PDBArchiveType))
+	c.add(pdbArchiveArgs(config)...)
+	c.add(sysDBAArgs(config)...)
+
+	return c
+}
+
+// buildPlugPDBCommand builds the DBCA plug pluggable database command
+func buildPlugPDBCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-plugDatabase")
+	c.option("-sourceDB", config.SourceCDB)
+	c.option("-pdbName", config.NewPDBName)
+	c.add(pdbArchiveArgs(config)...)
+
+	if config.PDBCreateAsClone {
+		c.option("-createAsClone", "true")
+	}
+	if config.PDBArchiveType == model.PDBArchiveNone && config.PDBCopyFiles {
+		c.option("-copyPDBFiles", "true")
+	}
+
+	// File locations
+	if config.PDBSourceFileNameConvert != "" {
+		c.option("-sourceFileNameConvert", joinFileNameConvert(config.PDBSourceFileNameConvert))
+	}
+	if config.PDBFileNameConvert != "" {
+		c.option("-fileNameConvert", fileNameConvert(config))
+	}
+
+	c.add(sysDBAArgs(config)...)
+
+	return c
+}
+
+// buildRelocatePDBCommand builds the DBCA command moving a PDB from a
+// remote CDB into the local one over a database link
+func buildRelocatePDBCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, "-relocatePDB")
+	c.option("-sourceDB", config.SourceCDB)
+	c.option("-pdbName", RelocatedPDBName(config))
+	c.option("-remotePDBName", config.TargetPDB)
+	c.option("-remoteDBConnString", config.RemoteCDBConnectString)
+	c.option("-remoteDBSYSDBAUserName", config.RemoteSysDBAUser)
+	c.secret("-remoteDBSYSDBAUserPassword", "REMOTE_SYSDBA_PASSWORD", config.RemoteSysDBAPassword)
+	c.option("-dbLinkUsername", config.DBLinkUser)
+	c.secret("-dbLinkUserPassword", "DBLINK_PASSWORD", config.DBLinkPassword)
+	c.add(sysDBAArgs(config)...)
+
+	return c
+}
+
+// RelocatedPDBName returns the name of the relocated PDB; it keeps its
+// remote name unless a new one is given
+func RelocatedPDBName(config *model.DBConfig) string {
+	if name := strings.TrimSpace(config.NewPDBName); name != "" {
+		return name
+	}
+	return config.TargetPDB
+}
+
+// pdbArchiveArgs returns the options naming the files of an unplugged PDB
+func pdbArchiveArgs(config *model.DBConfig) []arg {
+	switch config.PDBArchiveType {
+	case model.PDBArchiveTAR:
+		return []arg{{name: "-pdbArchiveFile", value: config.PDBArchiveFile, hasValue: true}}
+	case model.PDBArchiveRMAN:
+		return []arg{
+			{name: "-PDBBackUpfile", value: config.PDBBackupFile, hasValue: true},
+			{name: "-PDBMetadataFile", value: config.PDBMetadataFile, hasValue: true},
+		}
+	default:
+		return []arg{{name: "-PDBMetadataFile", value: config.PDBMetadataFile, hasValue: true}}
+	}
+}
+
+// sysDBAArgs returns the SYSDBA credentials; a wallet replaces the password,
+// and operating system authentication, when chosen, needs neither
+func sysDBAArgs(config *model.DBConfig) []arg {
+	if config.UsesOSAuthentication() {
+		return nil
+	}
+	if config.UsesWallet() {
+		return append([]arg{{name: "-sysDBAUserName", value: "SYS", hasValue: true}}, walletArgs(config)...)
+	}
+	return []arg{
+		{name: "-sysDBAUserName", value: "SYS", hasValue: true},
+		{name: "-sysDBAPassword", value: config.SysPassword, hasValue: true, secret: "SYS_PASSWORD"},
+	}
+}
+
+// createPDBResponseEntries maps the create pluggable database options to response file keys
+func createPDBResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	var entries []rspEntry
+	add := func(key, value string) {
+		entries = append(entries, rspEntry{key, value})
+	}
+
+	add("responseFileVersion", config.TargetVersion.ResponseFileSchema())
+	add("sourceDB", config.SourceCDB)
+	add("pdbName", config.NewPDBName)
+
+	switch config.PDBSource {
+	case model.PDBSourceClone:
+		add("sourcePDB", config.SourcePDB)
+	case model.PDBSourceXML:
+		add("createPDBFrom", "USINGXML")
+		add("PDBMetadataFile", config.PDBMetadataFile)
+	default:
+		add("createPDBFrom", "DEFAULT")
+	}
+
+	if config.PDBUseOMF {
+		add("pdbDatafileDestination", config.PDBDatafileDestination)
+	} else {
+		add("fileNameConvert", fileNameConvert(config))
+		add("useMetaDataFileLocation", strconv.FormatBool(config.PDBSource == model.PDBSourceXML && config.PDBFileNameConvert == ""))
+	}
+
+	seed := config.PDBSource == model.PDBSourceSeed
+	add("createNewPDBAdminUser", strconv.FormatBool(seed))
+	if seed {
+		add("pdbAdminUserName", config.PDBAdminUser)
+		add("pdbAdminPassword", password(config.PDBAdminPassword, maskPwd))
+	}
+
+	return entries
+}
+
+// deletePDBResponseEntries maps the delete pluggable database options to response file keys
+func deletePDBResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	entries := []rspEntry{
+		{"responseFileVersion", config.TargetVersion.ResponseFileSchema()},
+		{"sourceDB", config.SourceCDB},
+		{"pdbName", config.TargetPDB},
+	}
+	return append(entries, sysDBAResponseEntries(config, maskPwd)...)
+}
+
+// unplugPDBResponseEntries maps the unplug pluggable database options to response file keys
+func unplugPDBResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	entries := []rspEntry{
+		{"responseFileVersion", config.TargetVersion.ResponseFileSchema()},
+		{"sourceDB", config.SourceCDB},
+		{"pdbName", config.TargetPDB},
+		{"archiveType", string(config.PDBArchiveType)},
+	}
+	entries = append(entries, pdbArchiveResponseEntries(config)...)
+	return append(entries, sysDBAResponseEntries(config, maskPwd)...)
+}
+
+// plugPDBResponseEntries maps the plug pluggable database options to response file keys
+func plugPDBResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	entries := []rspEntry{
+		{"responseFileVersion", config.TargetVersion.ResponseFileSchema()},
+		{"sourceDB", config.SourceCDB},
+		{"pdbName", config.NewPDBName},
+	}
+	entries = append(entries, pdbArchiveResponseEntries(config)...)
+	entries = append(entries,
+		rspEntry{"createAsClone", strconv.FormatBool(config.PDBCreateAsClone)},
+		rspEntry{"copyPDBFiles", strconv.FormatBool(config.PDBArchiveType == model.PDBArchiveNone && config.PDBCopyFiles)},
+		rspEntry{"sourceFileNameConvert", joinFileNameConvert(config.PDBSourceFileNameConvert)},
+		rspEntry{"fileNameConvert", fileNameConvert(config)},
+	)
+	return append(entries, sysDBAResponseEntries(config, maskPwd)...)
+}
+
+// relocatePDBResponseEntries maps the relocate pluggable database options to response file keys
+func relocatePDBResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	entries := []rspEntry{
+		{"responseFileVersion", config.TargetVersion.ResponseFileSchema()},
+		{"sourceDB", config.SourceCDB},
+		{"pdbName", RelocatedPDBName(config)},
+		{"remotePDBName", config.TargetPDB},
+		{"remoteDBConnString", config.RemoteCDBConnectString},
+		{"remoteDBSYSDBAUserName", config.RemoteSysDBAUser},
+		{"remoteDBSYSDBAUserPassword", password(config.RemoteSysDBAPassword, maskPwd)},
+		{"dbLinkUsername", config.DBLinkUser},
+		{"dbLinkUserPassword", password(config.DBLinkPassword, maskPwd)},
+	}
+	return append(entries, sysDBAResponseEntries(config, maskPwd)...)
+}
+
+// pdbArchiveResponseEntries maps the files of an unplugged PDB to response file keys
+func pdbArchiveResponseEntries(config *model.DBConfig) []rspEntry {
+	switch config.PDBArchiveType {
+	case model.PDBArchiveTAR:
+		return []rspEntry{{"pdbArchiveFile", config.PDBArchiveFile}}
+	case model.PDBArchiveRMAN:
+		return []rspEntry{
+			{"PDBBackUpfile", config.PDBBackupFile},
+			{"PDBMetadataFile", config.PDBMetadataFile},
+		}
+	default:
+		return []rspEntry{{"PDBMetadataFile", config.PDBMetadataFile}}
+	}
+}
+
+// sysDBAResponseEntries maps the SYSDBA credentials to response file keys
+func sysDBAResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	if config.UsesOSAuthentication() {
+		return nil
+	}
+	if config.UsesWallet() {
+		return append([]rspEntry{{"sysDBAUserName", "SYS"}}, walletResponseEntries(config)...)
+	}
+	return []rspEntry{
+		{"sysDBAUserName", "SYS"},
+		{"sysDBAPassword", password(config.SysPassword, maskPwd)},
+	}
+}
+
+// fileNameConvert normalizes the file name convert pairs to an unquoted comma list
+func fileNameConvert(config *model.DBConfig) string {
+	return joinFileNameConvert(config.PDBFileNameConvert)
+}
+
+// joinFileNameConvert normalizes a list of convert pairs to an unquoted comma list
+func joinFileNameConvert(value string) string {
+	if strings.TrimSpace(value) == "" {
+		return ""
+	}
+	return strings.Join(model.SplitFileNameConvert(value), ",")
+}
@@ -0,0 +1,263 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// rspEntry is a single key=value line of a DBCA response file
+type rspEntry struct {
+	key   string
+	value string
+}
+
+// GenerateResponseFile generates a DBCA response file (with masked passwords)
+func GenerateResponseFile(config *model.DBConfig) string {
+	return renderResponseFile(config, responseEntries(config, true))
+}
+
+// GenerateResponseFileWithPasswords generates the response file with actual passwords
+func GenerateResponseFileWithPasswords(config *model.DBConfig) string {
+	return renderResponseFile(config, responseEntries(config, false))
+}
+
+// responseEntries dispatches to the key mapping of the configured operation
+func responseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	switch config.Operation {
+	case model.OperationDelete:
+		return deleteResponseEntries(config, maskPwd)
+	case model.OperationCreatePDB:
+		return createPDBResponseEntries(config, maskPwd)
+	case model.OperationDeletePDB:
+		return deletePDBResponseEntries(config, maskPwd)
+	case model.OperationUnplugPDB:
+		return unplugPDBResponseEntries(config, maskPwd)
+	case model.OperationPlugPDB:
+		return plugPDBResponseEntries(config, maskPwd)
+	case model.OperationRelocatePDB:
+		return relocatePDBResponseEntries(config, maskPwd)
+	case model.OperationConfigure:
+		return configureResponseEntries(config, maskPwd)
+	case model.OperationTemplate:
+		return templateResponseEntries(config, maskPwd)
+	case model.OperationDuplicate:
+		return duplicateResponseEntries(config, maskPwd)
+	case model.OperationAddInstance, model.OperationDeleteInstance:
+		return instanceResponseEntries(config, maskPwd)
+	default:
+		return createResponseEntries(config, maskPwd)
+	}
+}
+
+// renderResponseFile renders the entries with a header explaining how to use the file
+func renderResponseFile(config *model.DBConfig, entries []rspEntry) string {
+	var b strings.Builder
+
+	b.WriteString("##############################################################################\n")
+	b.WriteString("# DBCA Response File\n")
+	b.WriteString("# Generated by DBCA TUI\n")
+	b.WriteString("#\n")
+	b.WriteString(fmt.Sprintf("# Usage: %s -silent %s -responseFile <this file>\n", dbcaPath(config), operationFlag(config)))
+	b.WriteString("##############################################################################\n")
+
+	for _, e := range entries {
+		b.WriteString(e.key + "=" + e.value + "\n")
+	}
+
+	// Keys imported from another response file that the wizard does not map
+	if len(config.ExtraResponseParams) > 0 {
+		b.WriteString("#\n# Preserved from imported response file\n")
+		for _, key := range sortedKeys(config.ExtraResponseParams) {
+			b.WriteString(key + "=" + config.ExtraResponseParams[key] + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// deleteResponseEntries maps the delete options to response file keys
+func deleteResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	entries := []rspEntry{
+		{"responseFileVersion", config.TargetVersion.ResponseFileSchema()},
+		{"sourceDB", config.DeleteSID},
+		{"sysDBAUserName", "SYS"},
+	}
+	if config.UsesWallet() {
+		entries = append(entries, walletResponseEntries(config)...)
+	} else {
+		entries = append(entries, rspEntry{"sysDBAPassword", password(config.SysPassword, maskPwd)})
+	}
+	return append(entries, rspEntry{"forceArchiveLogDeletion", strconv.FormatBool(config.DeleteForce)})
+}
+
+// createResponseEntries maps the create options to the dbca.rsp keys
+func createResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	var entries []rspEntry
+	add := func(key, value string) {
+		entries = append(entries, rspEntry{key, value})
+	}
+
+	add("responseFileVersion", config.TargetVersion.ResponseFileSchema())
+
+	// Database identification
+	add("gdbName", config.GlobalDBName)
+	add("sid", config.SID)
+
+	// Deployment type
+	add("databaseConfigType", string(config.DeploymentType))
+	add("RACOneNodeServiceName", "")
+	add("policyManaged", "false")
+	add("nodelist", config.NodeList)
+
+	// Container database settings
+	add("createAsContainerDatabase", strconv.FormatBool(config.CreateAsContainerDB))
+	if config.CreateAsContainerDB && config.NumberOfPDBs > 0 {
+		add("numberOfPDBs", strconv.Itoa(config.NumberOfPDBs))
+		add("pdbName", config.PDBName)
+		add("pdbAdminPassword", password(config.PDBAdminPassword, maskPwd))
+	} else {
+		add("numberOfPDBs", "0")
+		add("pdbName", "")
+		add("pdbAdminPassword", "")
+	}
+
+	if config.CreateAsContainerDB {
+		add("useLocalUndoForPDBs", strconv.FormatBool(config.UseLocalUndoForPDBs))
+	}
+
+	// Template
+	templateName := ""
+	if config.TemplateName != model.TemplateCustom {
+		templateName = config.TargetVersion.TemplateFile(config.TemplateName)
+	}
+	add("templateName", templateName)
+
+	// Passwords, or the wallet holding them
+	if config.UsesWallet() {
+		add("sysPassword", "")
+		add("systemPassword", "")
+		entries = append(entries, walletResponseEntries(config)...)
+	} else {
+		add("sysPassword", password(config.SysPassword, maskPwd))
+		add("systemPassword", password(config.SystemPassword, maskPwd))
+	}
+
+	// Enterprise Manager configuration
+	add("emConfiguration", string(config.EMConfiguration))
+	emExpressPort := ""
+	if config.EMConfiguration == model.EMConfigDBExpress {
+		emExpressPort = strconv.Itoa(config.EMPort)
+	}
+	add("emExpressPort", emExpressPort)
+	omsHost, omsPort := "", ""
+	if config.EMConfiguration == model.EMConfigCentral {
+		omsHost = config.CloudControlAgent
+		omsPort = strconv.Itoa(config.EMPort)
+	}
+	add("omsHost", omsHost)
+	add("omsPort", omsPort)
+
+	// Data Vault
+	add("dvConfiguration", strconv.FormatBool(config.EnableDataVault))
+	if config.EnableDataVault {
+		add("dvUserName", config.DataVaultOwner)
+		add("dvAccountManagerName", config.DataVaultAccountManager)
+	} else {
+		add("dvUserName", "")
+		add("dvAccountManagerName", "")
+	}
+	add("olsConfiguration", strconv.FormatBool(config.EnableLabelSecurity))
+
+	// Storage configuration
+	add("storageType", string(config.StorageType))
+	if config.StorageType == model.StorageTypeASM {
+		add("diskGroupName", config.ASMDiskGroup)
+		add("datafileDestination", "")
+	} else {
+		add("diskGroupName", "")
+		add("datafileDestination", config.DatafileDestination)
+	}
+	add("useOMF", strconv.FormatBool(config.UseOMF))
+	add("redoLogFileSize", strconv.Itoa(config.RedoLogFileSize))
+
+	// Fast Recovery Area and archiving
+	if config.EnableFRA {
+		add("recoveryAreaDestination", config.FRADestination)
+		add("recoveryAreaSize", strconv.Itoa(config.FRASize))
+	} else {
+		add("recoveryAreaDestination", "")
+		add("recoveryAreaSize", "")
+	}
+	add("enableArchive", strconv.FormatBool(config.EnableArchiveLog))
+
+	// Character sets
+	add("characterSet", config.CharacterSet)
+	add("nationalCharacterSet", config.NationalCharacterSet)
+
+	// Listener configuration
+	listeners := ""
+	if config.ListenerName != "" && config.ListenerName != "LISTENER" {
+		listeners = config.ListenerName
+	}
+	add("listeners", listeners)
+
+	// Initialization parameters and sample schemas
+	add("initParams", joinInitParams(allInitParams(config)))
+	add("sampleSchema", strconv.FormatBool(config.EnableSampleSchemas))
+
+	// Memory configuration
+	add("databaseType", string(config.DatabaseType))
+	add("automaticMemoryManagement", strconv.FormatBool(config.MemoryManagement == "AUTO"))
+	add("totalMemory", strconv.Itoa(config.TotalMemory))
+
+	return entries
+}
+
+// password returns the password or a placeholder when masking
+func password(pwd string, maskPwd bool) string {
+	if maskPwd {
+		return maskedPassword
+	}
+	return pwd
+}
+
+// joinInitParams renders init parameters as a sorted name=value list
+func joinInitParams(params map[string]string) string {
+	var parts []string
+	for _, name := range sortedKeys(params) {
+		parts = append(parts, name+"="+initParamValue(params[name]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// initParamValue quotes a value holding a list, such as DB,EXTENDED, so
+// DBCA and the response file importer do not split the parameter at its
+// commas. Values already enclosed in quotes are left alone.
+func initParamValue(value string) string {
+	if !strings.Contains(value, ",") || isQuoted(value) {
+		return value
+	}
+	if strings.Contains(value, "'") {
+		return `"` + value + `"`
+	}
+	return "'" + value + "'"
+}
+
+// isQuoted returns true for a value enclosed in single or double quotes
+func isQuoted(value string) bool {
+	return len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0]
+}
+
+// sortedKeys returns the keys of a map in sorted order
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
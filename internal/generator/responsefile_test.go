@@ -0,0 +1,23 @@
+package generator
+
+import "testing"
+
+func TestJoinInitParams(t *testing.T) {
+	tests := []struct {
+		params map[string]string
+		want   string
+	}{
+		{map[string]string{"open_cursors": "300"}, "open_cursors=300"},
+		{map[string]string{"audit_trail": "DB,EXTENDED", "processes": "300"}, "audit_trail='DB,EXTENDED',processes=300"},
+		{map[string]string{"audit_trail": "'DB,EXTENDED'"}, "audit_trail='DB,EXTENDED'"},
+		{map[string]string{"nls_date_format": "DD-MON-RR"}, "nls_date_format=DD-MON-RR"},
+		{map[string]string{"x": "it's,a list"}, `x="it's,a list"`},
+		{map[string]string{"log_archive_config": "dg_config=(a,b)"}, "log_archive_config='dg_config=(a,b)'"},
+	}
+
+	for _, tt := range tests {
+		if got := joinInitParams(tt.params); got != tt.want {
+			t.Errorf("joinInitParams(%v) = %s, want %s", tt.params, got, tt.want)
+		}
+	}
+}
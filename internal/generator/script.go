@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// SecretMode selects how a generated script gets its passwords
+type SecretMode string
+
+const (
+	SecretsInline SecretMode = "inline" // Written into the script
+	SecretsEnv    SecretMode = "env"    // Read from environment variables, prompting for unset ones
+	SecretsStdin  SecretMode = "stdin"  // Read from standard input, one per line
+)
+
+// SecretModes lists the secret modes in the order the Summary screen cycles through them
+var SecretModes = []SecretMode{SecretsEnv, SecretsStdin, SecretsInline}
+
+// Description returns a short human readable name for the mode
+func (m SecretMode) Description() string {
+	switch m {
+	case SecretsInline:
+		return "Written into the script"
+	case SecretsStdin:
+		return "Read from standard input"
+	default:
+		return "Environment variables, prompted when unset"
+	}
+}
+
+// IsValid returns true for a known secret mode
+func (m SecretMode) IsValid() bool {
+	switch m {
+	case SecretsInline, SecretsEnv, SecretsStdin:
+		return true
+	}
+	return false
+}
+
+// GenerateScript generates an executable shell script running the command.
+// Outside the inline mode the script holds no passwords and can be kept
+// under version control. The passwords still reach dbca on its command
+// line, where ps shows them to other users of the host.
+func GenerateScript(config *model.DBConfig, mode SecretMode) string {
+	c := buildCommand(config)
+	variables := c.secretVariables()
+	if mode == SecretsInline {
+		variables = nil
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("#!/bin/bash\n# DBCA Silent Mode Command - %s\n# Generated by DBCA TUI\n", OperationTitle(config)))
+	switch {
+	case len(variables) > 0 && mode == SecretsStdin:
+		b.WriteString("#\n# Passwords are read from standard input, one per line, in this order:\n")
+		for _, v := range variables {
+			b.WriteString("#   " + v + "\n")
+		}
+	case len(variables) > 0:
+		b.WriteString("#\n# Passwords are read from the environment; unset ones are prompted for:\n")
+		b.WriteString("#   " + strings.Join(variables, " ") + "\n")
+	}
+	if len(variables) > 0 {
+		// Only the file is kept free of passwords, not the dbca process
+		b.WriteString("#\n# dbca still receives the passwords as arguments, so other users of this\n")
+		b.WriteString("# host can see them with ps while it runs.\n")
+	}
+	if config.UsesWallet() {
+		alias := config.WalletAlias
+		if alias == "" {
+			alias = "<connect_string>"
+		}
+		b.WriteString("#\n# SYS credentials are read from the wallet; store them with:\n")
+		b.WriteString(fmt.Sprintf("#   mkstore -wrl %s -createCredential %s SYS\n", QuotePOSIX(config.WalletLocation), QuotePOSIX(alias)))
+	}
+	b.WriteString("\n")
+
+	if config.OracleHome != "" {
+		b.WriteString(fmt.Sprintf("export ORACLE_HOME=%s\n\n", QuotePOSIX(config.OracleHome)))
+	}
+
+	for _, v := range variables {
+		if mode == SecretsStdin {
+			b.WriteString(fmt.Sprintf("IFS= read -r -s %s || { echo \"%s missing on standard input\" >&2; exit 1; }\n", v, v))
+		} else {
+			b.WriteString(fmt.Sprintf("if [ -z \"${%s:-}\" ]; then\n", v))
+			b.WriteString(fmt.Sprintf("  IFS= read -r -s -p \"%s: \" %s\n", v, v))
+			b.WriteString("  echo >&2\n")
+			b.WriteString("fi\n")
+		}
+	}
+	if len(variables) > 0 {
+		b.WriteString("\n")
+	}
+
+	if mode == SecretsInline {
+		b.WriteString(c.render(ShellPOSIX, secretsActual))
+	} else {
+		b.WriteString(c.render(ShellPOSIX, secretsVariable))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
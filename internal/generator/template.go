@@ -0,0 +1,36 @@
+package generator
+
+import (
+	"dbca_tui/internal/model"
+)
+
+// buildTemplateCommand builds the DBCA command creating a template from
+// an existing database: the structure only, or a clone template including the datafiles
+func buildTemplateCommand(config *model.DBConfig) *command {
+	c := newCommand(config.OracleHome, operationFlag(config))
+	if config.TemplateIncludeDatafiles {
+		c.option("-sourceSID", config.TemplateSourceDB)
+	} else {
+		c.option("-sourceDB", config.TemplateSourceDB)
+	}
+	c.option("-templateName", config.NewTemplateName)
+	// Only a clone template can use operating system authentication
+	c.add(sysDBAArgs(config)...)
+
+	return c
+}
+
+// templateResponseEntries maps the create template options to response file keys
+func templateResponseEntries(config *model.DBConfig, maskPwd bool) []rspEntry {
+	// The source key matches the option of the command
+	sourceKey := "sourceDB"
+	if config.TemplateIncludeDatafiles {
+		sourceKey = "sourceSID"
+	}
+	entries := []rspEntry{
+		{"responseFileVersion", config.TargetVersion.ResponseFileSchema()},
+		{sourceKey, config.TemplateSourceDB},
+		{"templateName", config.NewTemplateName},
+	}
+	return append(entries, sysDBAResponseEntries(config, maskPwd)...)
+}
@@ -0,0 +1,22 @@
+package generator
+
+import (
+	"dbca_tui/internal/model"
+)
+
+// walletArgs returns the options reading the database credentials from an
+// Oracle wallet instead of the command line
+func walletArgs(config *model.DBConfig) []arg {
+	return []arg{
+		{name: "-useWalletForDBCredentials", value: "true", hasValue: true},
+		{name: "-dbCredentialsWalletLocation", value: config.WalletLocation, hasValue: true},
+	}
+}
+
+// walletResponseEntries maps the wallet options to response file keys
+func walletResponseEntries(config *model.DBConfig) []rspEntry {
+	return []rspEntry{
+		{"useWalletForDBCredentials", "true"},
+		{"dbCredentialsWalletLocation", config.WalletLocation},
+	}
+}
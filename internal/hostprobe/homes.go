@@ -0,0 +1,133 @@
+package hostprobe
+
+import (
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// InventoryPointerPaths are the locations searched for oraInst.loc, which
+// names the central inventory directory
+var InventoryPointerPaths = []string{"/etc/oraInst.loc", "/var/opt/oracle/oraInst.loc"}
+
+// OracleHome is a database home found on the host
+type OracleHome struct {
+	Path    string
+	Name    string   // Inventory name such as OraDB19Home1; empty when not in the inventory
+	Sources []string // Where the home was found: ORACLE_HOME, oratab, inventory
+	HasDBCA bool     // bin/dbca exists in the home
+}
+
+// InventoryHome is a HOME element of the central inventory
+type InventoryHome struct {
+	Name    string `xml:"NAME,attr"`
+	Loc     string `xml:"LOC,attr"`
+	CRS     bool   `xml:"CRS,attr"`     // Grid Infrastructure home
+	Removed string `xml:"REMOVED,attr"` // T once the home was detached
+}
+
+// DetectOracleHomes returns the database homes named by the ORACLE_HOME
+// environment variable, oratab and the central inventory, in that order.
+// Sources that cannot be read are skipped; Grid Infrastructure homes are
+// left out as they have no dbca.
+func DetectOracleHomes() []OracleHome {
+	var homes []OracleHome
+	add := func(path, name, source string) {
+		path = filepath.Clean(path)
+		for i := range homes {
+			if homes[i].Path == path {
+				if !slices.Contains(homes[i].Sources, source) {
+					homes[i].Sources = append(homes[i].Sources, source)
+				}
+				if homes[i].Name == "" {
+					homes[i].Name = name
+				}
+				return
+			}
+		}
+		_, err := os.Stat(filepath.Join(path, "bin", "dbca"))
+		homes = append(homes, OracleHome{
+			Path:    path,
+			Name:    name,
+			Sources: []string{source},
+			HasDBCA: err == nil,
+		})
+	}
+
+	if home := os.Getenv("ORACLE_HOME"); home != "" {
+		add(home, "", "ORACLE_HOME")
+	}
+
+	// Database and home-only (*) entries; ASM entries point at the Grid home
+	entries, _, _ := ReadOratab()
+	for _, entry := range entries {
+		if entry.IsDatabase() || entry.SID == "*" {
+			add(entry.OracleHome, "", "oratab")
+		}
+	}
+
+	inventory, _ := ReadInventory()
+	for _, home := range inventory {
+		if !home.CRS && home.Removed != "T" {
+			add(home.Loc, home.Name, "inventory")
+		}
+	}
+
+	return homes
+}
+
+// ReadInventory reads the homes of the central inventory that oraInst.loc
+// points to. A host without an inventory has no homes and no error.
+func ReadInventory() ([]InventoryHome, error) {
+	for _, path := range InventoryPointerPaths {
+		f, err := os.Open(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		dir := ParseInventoryPointer(f)
+		f.Close()
+		if dir == "" {
+			return nil, nil
+		}
+
+		inv, err := os.Open(filepath.Join(dir, "ContentsXML", "inventory.xml"))
+		if err != nil {
+			return nil, err
+		}
+		defer inv.Close()
+		return ParseInventory(inv)
+	}
+	return nil, nil
+}
+
+// ParseInventoryPointer returns the inventory_loc of an oraInst.loc file
+func ParseInventoryPointer(r io.Reader) string {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, value, ok := strings.Cut(scanner.Text(), "=")
+		if ok && strings.TrimSpace(key) == "inventory_loc" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return ""
+}
+
+// ParseInventory parses the HOME_LIST of ContentsXML/inventory.xml
+func ParseInventory(r io.Reader) ([]InventoryHome, error) {
+	var inventory struct {
+		Homes []InventoryHome `xml:"HOME_LIST>HOME"`
+	}
+	if err := xml.NewDecoder(r).Decode(&inventory); err != nil {
+		return nil, err
+	}
+	return inventory.Homes, nil
+}
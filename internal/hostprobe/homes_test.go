@@ -0,0 +1,105 @@
+package hostprobe
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const inventory = `<?xml version="1.0" standalone="yes" ?>
+<INVENTORY>
+<VERSION_INFO>
+   <SAVED_WITH>12.2.0.7.0</SAVED_WITH>
+   <MINIMUM_VER>2.1.0.6.0</MINIMUM_VER>
+</VERSION_INFO>
+<HOME_LIST>
+<HOME NAME="OraGI19Home1" LOC="/u01/app/19.0.0/grid" TYPE="O" IDX="1" CRS="true"/>
+<HOME NAME="OraDB19Home1" LOC="/u01/app/oracle/product/19.0.0/dbhome_1" TYPE="O" IDX="2"/>
+<HOME NAME="OraDB12Home1" LOC="/u01/app/oracle/product/12.2.0/dbhome_1" TYPE="O" IDX="3" REMOVED="T"/>
+</HOME_LIST>
+<COMPOSITEHOME_LIST>
+</COMPOSITEHOME_LIST>
+</INVENTORY>
+`
+
+func TestParseInventory(t *testing.T) {
+	homes, err := ParseInventory(strings.NewReader(inventory))
+	if err != nil {
+		t.Fatalf("ParseInventory() error = %v", err)
+	}
+
+	want := []InventoryHome{
+		{Name: "OraGI19Home1", Loc: "/u01/app/19.0.0/grid", CRS: true},
+		{Name: "OraDB19Home1", Loc: "/u01/app/oracle/product/19.0.0/dbhome_1"},
+		{Name: "OraDB12Home1", Loc: "/u01/app/oracle/product/12.2.0/dbhome_1", Removed: "T"},
+	}
+	if !slices.Equal(homes, want) {
+		t.Errorf("ParseInventory() = %v, want %v", homes, want)
+	}
+
+	if _, err := ParseInventory(strings.NewReader("<INVENTORY><HOME_LIST>")); err == nil {
+		t.Errorf("ParseInventory() of truncated XML succeeded")
+	}
+}
+
+func TestParseInventoryPointer(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"inventory_loc=/u01/app/oraInventory\ninst_group=oinstall\n", "/u01/app/oraInventory"},
+		{"inst_group=oinstall\n inventory_loc = /u01/app/oraInventory \n", "/u01/app/oraInventory"},
+		{"inst_group=oinstall\n", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		if got := ParseInventoryPointer(strings.NewReader(tt.input)); got != tt.want {
+			t.Errorf("ParseInventoryPointer(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDetectOracleHomes(t *testing.T) {
+	dir := t.TempDir()
+	write := func(path, content string) {
+		path = filepath.Join(dir, path)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	db19 := "/u01/app/oracle/product/19.0.0/dbhome_1"
+	write("oratab", "sales:"+db19+":Y\n*:/u01/app/oracle/product/21.0.0/dbhome_1:N\n+ASM1:/u01/app/19.0.0/grid:N\n")
+	write("oraInst.loc", "inventory_loc="+filepath.Join(dir, "oraInventory")+"\n")
+	write("oraInventory/ContentsXML/inventory.xml", inventory)
+	write("env_home/bin/dbca", "")
+
+	savedOratab, savedPointer := OratabPaths, InventoryPointerPaths
+	defer func() { OratabPaths, InventoryPointerPaths = savedOratab, savedPointer }()
+	OratabPaths = []string{filepath.Join(dir, "oratab")}
+	InventoryPointerPaths = []string{filepath.Join(dir, "oraInst.loc")}
+	t.Setenv("ORACLE_HOME", filepath.Join(dir, "env_home")+"/")
+
+	// The Grid home (CRS, +ASM) and the removed home are left out
+	want := []OracleHome{
+		{Path: filepath.Join(dir, "env_home"), Sources: []string{"ORACLE_HOME"}, HasDBCA: true},
+		{Path: db19, Name: "OraDB19Home1", Sources: []string{"oratab", "inventory"}},
+		{Path: "/u01/app/oracle/product/21.0.0/dbhome_1", Sources: []string{"oratab"}},
+	}
+	got := DetectOracleHomes()
+	if len(got) != len(want) {
+		t.Fatalf("DetectOracleHomes() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Path != want[i].Path || got[i].Name != want[i].Name ||
+			!slices.Equal(got[i].Sources, want[i].Sources) || got[i].HasDBCA != want[i].HasDBCA {
+			t.Errorf("DetectOracleHomes()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
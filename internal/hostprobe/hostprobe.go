@@ -0,0 +1,132 @@
+package hostprobe
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// Paths holds the files the probe reads. They can be pointed at fixtures
+// for testing or at a copy taken from the target host.
+type Paths struct {
+	Meminfo string
+	CPUInfo string
+}
+
+// DefaultPaths are the Linux procfs locations
+var DefaultPaths = Paths{
+	Meminfo: "/proc/meminfo",
+	CPUInfo: "/proc/cpuinfo",
+}
+
+// HostInfo describes the memory and CPU resources of a host
+type HostInfo struct {
+	TotalMemoryMB     int
+	AvailableMemoryMB int
+	CPUCount          int
+	HugePagesTotal    int // Number of pre-allocated HugePages
+	HugePagesFree     int
+	HugePageSizeKB    int
+}
+
+// HugePagesConfigured returns true if HugePages are pre-allocated
+func (h *HostInfo) HugePagesConfigured() bool {
+	return h.HugePagesTotal > 0
+}
+
+// HugePagesMB returns the memory reserved for HugePages
+func (h *HostInfo) HugePagesMB() int {
+	return h.HugePagesTotal * h.HugePageSizeKB / 1024
+}
+
+// Probe reads the host information from the default paths
+func Probe() (*HostInfo, error) {
+	return ProbeWith(DefaultPaths)
+}
+
+// ProbeWith reads the host information from the given paths. The CPU
+// count falls back to the Go runtime when the cpuinfo file is unreadable.
+func ProbeWith(paths Paths) (*HostInfo, error) {
+	f, err := os.Open(paths.Meminfo)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := ParseMeminfo(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", paths.Meminfo, err)
+	}
+
+	info.CPUCount = runtime.NumCPU()
+	if cpu, err := os.Open(paths.CPUInfo); err == nil {
+		if n := countProcessors(cpu); n > 0 {
+			info.CPUCount = n
+		}
+		cpu.Close()
+	}
+
+	return info, nil
+}
+
+// ParseMeminfo parses the /proc/meminfo format
+func ParseMeminfo(r io.Reader) (*HostInfo, error) {
+	info := &HostInfo{}
+	found := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, rest, ok := strings.Cut(scanner.Text(), ":")
+		if !ok {
+			continue
+		}
+		fields := strings.Fields(rest)
+		if len(fields) == 0 {
+			continue
+		}
+		n, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+
+		// Sizes are reported in kB, HugePages counts are plain numbers
+		switch key {
+		case "MemTotal":
+			info.TotalMemoryMB = n / 1024
+			found = true
+		case "MemAvailable":
+			info.AvailableMemoryMB = n / 1024
+		case "HugePages_Total":
+			info.HugePagesTotal = n
+		case "HugePages_Free":
+			info.HugePagesFree = n
+		case "Hugepagesize":
+			info.HugePageSizeKB = n
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("MemTotal not found")
+	}
+
+	return info, nil
+}
+
+// countProcessors counts the "processor" entries of /proc/cpuinfo
+func countProcessors(r io.Reader) int {
+	n := 0
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		key, _, ok := strings.Cut(scanner.Text(), ":")
+		if ok && strings.TrimSpace(key) == "processor" {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,117 @@
+package hostprobe
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+const meminfoHugePages = `MemTotal:       16303464 kB
+MemFree:          512344 kB
+MemAvailable:    9214560 kB
+Buffers:          102400 kB
+HugePages_Total:    3074
+HugePages_Free:     1024
+HugePages_Rsvd:        0
+HugePages_Surp:        0
+Hugepagesize:       2048 kB
+Hugetlb:         6295552 kB
+`
+
+// meminfoNoHugePages is the output of a kernel built without HugePages
+const meminfoNoHugePages = `MemTotal:        8388608 kB
+MemFree:         4194304 kB
+MemAvailable:    6291456 kB
+`
+
+func TestParseMeminfo(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		want        HostInfo
+		wantHugeMB  int
+		wantHugeSet bool
+	}{
+		{
+			name:        "HugePages",
+			input:       meminfoHugePages,
+			want:        HostInfo{TotalMemoryMB: 15921, AvailableMemoryMB: 8998, HugePagesTotal: 3074, HugePagesFree: 1024, HugePageSizeKB: 2048},
+			wantHugeMB:  6148,
+			wantHugeSet: true,
+		},
+		{
+			name:  "missing HugePages lines",
+			input: meminfoNoHugePages,
+			want:  HostInfo{TotalMemoryMB: 8192, AvailableMemoryMB: 6144},
+		},
+		{
+			name:  "HugePages size without pages",
+			input: "MemTotal: 2097152 kB\nHugePages_Total: 0\nHugepagesize: 1048576 kB\n",
+			want:  HostInfo{TotalMemoryMB: 2048, HugePageSizeKB: 1048576},
+		},
+		{
+			name:  "malformed lines are skipped",
+			input: "garbage\nMemTotal:\nMemTotal: lots kB\nMemTotal: 1048576 kB\n",
+			want:  HostInfo{TotalMemoryMB: 1024},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMeminfo(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("%s: ParseMeminfo() error = %v", tt.name, err)
+			continue
+		}
+		if *got != tt.want {
+			t.Errorf("%s: ParseMeminfo() = %+v, want %+v", tt.name, *got, tt.want)
+		}
+		if got.HugePagesMB() != tt.wantHugeMB || got.HugePagesConfigured() != tt.wantHugeSet {
+			t.Errorf("%s: HugePagesMB() = %d, HugePagesConfigured() = %t, want %d, %t",
+				tt.name, got.HugePagesMB(), got.HugePagesConfigured(), tt.wantHugeMB, tt.wantHugeSet)
+		}
+	}
+}
+
+func TestParseMeminfoWithoutMemTotal(t *testing.T) {
+	if _, err := ParseMeminfo(strings.NewReader("MemFree: 512344 kB\n")); err == nil {
+		t.Errorf("ParseMeminfo() without MemTotal succeeded")
+	}
+}
+
+func TestProbeWith(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	meminfo := write("meminfo", meminfoHugePages)
+	cpuinfo := write("cpuinfo", "processor\t: 0\nmodel name\t: x\n\nprocessor\t: 1\n\nprocessor\t: 2\n")
+
+	tests := []struct {
+		name    string
+		paths   Paths
+		wantCPU int
+		wantErr bool
+	}{
+		{"fixtures", Paths{Meminfo: meminfo, CPUInfo: cpuinfo}, 3, false},
+		{"unreadable cpuinfo", Paths{Meminfo: meminfo, CPUInfo: filepath.Join(dir, "missing")}, runtime.NumCPU(), false},
+		{"missing meminfo", Paths{Meminfo: filepath.Join(dir, "missing"), CPUInfo: cpuinfo}, 0, true},
+		{"invalid meminfo", Paths{Meminfo: cpuinfo, CPUInfo: cpuinfo}, 0, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ProbeWith(tt.paths)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: ProbeWith() error = %v, want error %t", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (got.CPUCount != tt.wantCPU || got.TotalMemoryMB != 15921) {
+			t.Errorf("%s: ProbeWith() = %+v, want %d CPUs and 15921 MB", tt.name, *got, tt.wantCPU)
+		}
+	}
+}
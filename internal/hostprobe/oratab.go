@@ -0,0 +1,90 @@
+package hostprobe
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// OratabPaths are the locations searched for the oratab file in order;
+// Solaris keeps it under /var/opt/oracle. The wizard's --oratab option
+// replaces them with a single file.
+var OratabPaths = []string{"/etc/oratab", "/var/opt/oracle/oratab"}
+
+// OratabEntry is a SID:ORACLE_HOME:Y|N line of the oratab file
+type OratabEntry struct {
+	SID        string
+	OracleHome string
+	AutoStart  bool // dbstart starts the instance at boot
+}
+
+// IsDatabase returns false for the entries that only register a home (*),
+// an ASM or APX instance (+ASM) or the management repository (-MGMTDB)
+func (e OratabEntry) IsDatabase() bool {
+	return e.SID != "*" && !strings.HasPrefix(e.SID, "+") && !strings.HasPrefix(e.SID, "-")
+}
+
+// ReadOratab reads the first oratab file found in OratabPaths and returns
+// its entries with the path they were read from. A host without an oratab
+// file has no entries and no error; a file with malformed lines has the
+// entries of the other lines and an error naming the malformed ones.
+func ReadOratab() ([]OratabEntry, string, error) {
+	for _, path := range OratabPaths {
+		f, err := os.Open(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, path, err
+		}
+		entries, err := ParseOratab(f)
+		f.Close()
+		if err != nil {
+			return entries, path, fmt.Errorf("%s: %w", path, err)
+		}
+		return entries, path, nil
+	}
+	return nil, "", nil
+}
+
+// ParseOratab parses the oratab format. Comments and blank lines are
+// skipped; a missing autostart flag means N. A malformed line does not
+// hide the others: it is skipped and reported in the returned error.
+func ParseOratab(r io.Reader) ([]OratabEntry, error) {
+	var entries []OratabEntry
+	var malformed []error
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Split(line, ":")
+		if len(fields) < 2 || fields[0] == "" || fields[1] == "" {
+			malformed = append(malformed, fmt.Errorf("line %d: expected SID:ORACLE_HOME:Y|N, got %q", lineNo, line))
+			continue
+		}
+		entry := OratabEntry{
+			SID:        strings.TrimSpace(fields[0]),
+			OracleHome: strings.TrimSpace(fields[1]),
+		}
+		if len(fields) > 2 {
+			entry.AutoStart = strings.EqualFold(strings.TrimSpace(fields[2]), "Y")
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, errors.Join(malformed...)
+}
@@ -0,0 +1,89 @@
+package hostprobe
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const oratab = `#
+# This file is used by ORACLE utilities.
+#
+sales:/u01/app/oracle/product/19.0.0/dbhome_1:Y
+hr:/u01/app/oracle/product/19.0.0/dbhome_1:N   # trailing comment
+*:/u01/app/oracle/product/21.0.0/dbhome_1:N
++ASM1:/u01/app/19.0.0/grid:N
+-MGMTDB:/u01/app/19.0.0/grid:N
+noflag:/u01/app/oracle/product/19.0.0/dbhome_1
+lower:/u01/app/oracle/product/19.0.0/dbhome_1:y
+
+missinghome
+:/u01/app/oracle/product/19.0.0/dbhome_1:Y
+`
+
+func TestParseOratab(t *testing.T) {
+	entries, err := ParseOratab(strings.NewReader(oratab))
+
+	want := []OratabEntry{
+		{"sales", "/u01/app/oracle/product/19.0.0/dbhome_1", true},
+		{"hr", "/u01/app/oracle/product/19.0.0/dbhome_1", false},
+		{"*", "/u01/app/oracle/product/21.0.0/dbhome_1", false},
+		{"+ASM1", "/u01/app/19.0.0/grid", false},
+		{"-MGMTDB", "/u01/app/19.0.0/grid", false},
+		{"noflag", "/u01/app/oracle/product/19.0.0/dbhome_1", false},
+		{"lower", "/u01/app/oracle/product/19.0.0/dbhome_1", true},
+	}
+	if !slices.Equal(entries, want) {
+		t.Errorf("ParseOratab() = %v, want %v", entries, want)
+	}
+
+	// The malformed lines are reported alongside the valid entries
+	wantErr := `line 12: expected SID:ORACLE_HOME:Y|N, got "missinghome"` + "\n" +
+		`line 13: expected SID:ORACLE_HOME:Y|N, got ":/u01/app/oracle/product/19.0.0/dbhome_1:Y"`
+	if err == nil || err.Error() != wantErr {
+		t.Errorf("ParseOratab() error = %v, want %s", err, wantErr)
+	}
+}
+
+func TestOratabEntryIsDatabase(t *testing.T) {
+	tests := []struct {
+		sid  string
+		want bool
+	}{
+		{"sales", true},
+		{"*", false},
+		{"+ASM", false},
+		{"+APX1", false},
+		{"-MGMTDB", false},
+	}
+
+	for _, tt := range tests {
+		if got := (OratabEntry{SID: tt.sid}).IsDatabase(); got != tt.want {
+			t.Errorf("OratabEntry{SID: %q}.IsDatabase() = %t, want %t", tt.sid, got, tt.want)
+		}
+	}
+}
+
+func TestReadOratab(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "oratab")
+	if err := os.WriteFile(path, []byte("sales:/u01/db:Y\nbroken\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	saved := OratabPaths
+	defer func() { OratabPaths = saved }()
+
+	OratabPaths = []string{filepath.Join(dir, "missing"), path}
+	entries, got, err := ReadOratab()
+	if got != path || len(entries) != 1 || err == nil || !strings.HasPrefix(err.Error(), path+": line 2:") {
+		t.Errorf("ReadOratab() = %v, %s, %v, want 1 entry from %s and an error for line 2", entries, got, err, path)
+	}
+
+	OratabPaths = []string{filepath.Join(dir, "missing")}
+	if entries, got, err := ReadOratab(); entries != nil || got != "" || err != nil {
+		t.Errorf("ReadOratab() without a file = %v, %q, %v, want nothing", entries, got, err)
+	}
+}
@@ -0,0 +1,44 @@
+package hostprobe
+
+import "dbca_tui/internal/model"
+
+// databaseMemoryPercent is the share of host memory DBCA suggests for the instance
+const databaseMemoryPercent = 40
+
+// MemoryRecommendation is a suggested memory layout for a database type
+type MemoryRecommendation struct {
+	TotalMB    int // Memory for the instance
+	SGAPercent int // Share of TotalMB for the SGA
+	PGAPercent int // Share of TotalMB for the PGA
+	SGAMB      int
+	PGAMB      int
+}
+
+// SGAPGASplit returns the recommended SGA and PGA percentages for a database type
+func SGAPGASplit(dbType model.DatabaseType) (sgaPercent, pgaPercent int) {
+	switch dbType {
+	case model.DatabaseTypeOLTP:
+		return 80, 20
+	case model.DatabaseTypeDataWarehouse:
+		return 50, 50
+	default:
+		return 75, 25
+	}
+}
+
+// Recommend suggests a memory layout for the host and database type
+func Recommend(info *HostInfo, dbType model.DatabaseType) MemoryRecommendation {
+	sgaPercent, pgaPercent := SGAPGASplit(dbType)
+	rec := MemoryRecommendation{
+		SGAPercent: sgaPercent,
+		PGAPercent: pgaPercent,
+	}
+	if info == nil {
+		return rec
+	}
+
+	rec.TotalMB = info.TotalMemoryMB * databaseMemoryPercent / 100
+	rec.SGAMB = rec.TotalMB * sgaPercent / 100
+	rec.PGAMB = rec.TotalMB * pgaPercent / 100
+	return rec
+}
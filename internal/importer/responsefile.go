@@ -0,0 +1,773 @@
+package importer
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// LoadResponseFile reads a DBCA response file from disk
+func LoadResponseFile(path string) (*model.DBConfig, []string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	return ParseResponseFile(f)
+}
+
+// ParseResponseFile parses a DBCA response file into a DBConfig.
+// Both the 12.2+ "key=value" format and the legacy sectioned format
+// ([GENERAL], KEY = "value") are accepted. Keys the wizard does not
+// understand are preserved in ExtraResponseParams and returned so the
+// caller can report them. A key given more than once keeps its last value.
+func ParseResponseFile(r io.Reader) (*model.DBConfig, []string, error) {
+	config := model.NewDBConfig()
+	values := make(map[string]string)
+	var unknown []string
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+
+		// Skip comments, blank lines and legacy section headers
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "[") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, nil, fmt.Errorf("line %d: expected key=value, got %q", lineNo, line)
+		}
+		key = strings.TrimSpace(key)
+		value = unquote(strings.TrimSpace(value))
+
+		norm := normalizeKey(key)
+		if !knownKeys[norm] {
+			// A repeated key keeps its last value, like the known keys
+			if _, seen := config.ExtraResponseParams[key]; !seen {
+				unknown = append(unknown, key)
+			}
+			config.ExtraResponseParams[key] = value
+			continue
+		}
+		values[norm] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := applyValues(config, values); err != nil {
+		return nil, nil, err
+	}
+
+	sort.Strings(unknown)
+	return config, unknown, nil
+}
+
+// knownKeys lists the normalized response file keys mapped onto DBConfig
+var knownKeys = map[string]bool{
+	"responsefileversion":         true,
+	"operationtype":               true,
+	"gdbname":                     true,
+	"sid":                         true,
+	"databaseconfigtype":          true,
+	"raconenodeservicename":       true,
+	"policymanaged":               true,
+	"nodelist":                    true,
+	"createascontainerdatabase":   true,
+	"uselocalundoforpdbs":         true,
+	"numberofpdbs":                true,
+	"pdbname":                     true,
+	"pdbadminpassword":            true,
+	"templatename":                true,
+	"syspassword":                 true,
+	"systempassword":              true,
+	"emconfiguration":             true,
+	"emexpressport":               true,
+	"omshost":                     true,
+	"omsport":                     true,
+	"dvconfiguration":             true,
+	"dvusername":                  true,
+	"dvaccountmanagername":        true,
+	"dvuserpassword":              true,
+	"dvaccountmanagerpassword":    true,
+	"olsconfiguration":            true,
+	"storagetype":                 true,
+	"diskgroupname":               true,
+	"datafiledestination":         true,
+	"useomf":                      true,
+	"redologfilesize":             true,
+	"recoveryareadestination":     true,
+	"recoveryareasize":            true,
+	"enablearchive":               true,
+	"characterset":                true,
+	"nationalcharacterset":        true,
+	"listeners":                   true,
+	"initparams":                  true,
+	"sampleschema":                true,
+	"databasetype":                true,
+	"automaticmemorymanagement":   true,
+	"totalmemory":                 true,
+	"sourcedb":                    true,
+	"sourcesid":                   true,
+	"sysdbausername":              true,
+	"sysdbapassword":              true,
+	"forcearchivelogdeletion":     true,
+	"usewalletfordbcredentials":   true,
+	"dbcredentialswalletlocation": true,
+	"createpdbfrom":               true,
+	"sourcepdb":                   true,
+	"pdbmetadatafile":             true,
+	"pdbdatafiledestination":      true,
+	"filenameconvert":             true,
+	"usemetadatafilelocation":     true,
+	"createnewpdbadminuser":       true,
+	"pdbadminusername":            true,
+	"archivetype":                 true,
+	"pdbarchivefile":              true,
+	"pdbbackupfile":               true,
+	"createasclone":               true,
+	"copypdbfiles":                true,
+	"sourcefilenameconvert":       true,
+	"primarydbconnectionstring":   true,
+	"createasstandby":             true,
+	"dbuniquename":                true,
+	"createlistener":              true,
+	"nodename":                    true,
+	"instancename":                true,
+	"remotepdbname":               true,
+	"remotedbconnstring":          true,
+	"remotedbsysdbausername":      true,
+	"remotedbsysdbauserpassword":  true,
+	"dblinkusername":              true,
+	"dblinkuserpassword":          true,
+}
+
+// applyValues maps the collected response file values onto the config
+func applyValues(config *model.DBConfig, values map[string]string) error {
+	str := func(key string, dst *string) {
+		if v, ok := values[key]; ok && v != "" {
+			*dst = v
+		}
+	}
+	boolean := func(key string, dst *bool) error {
+		v, ok := values[key]
+		if !ok || v == "" {
+			return nil
+		}
+		b, err := strconv.ParseBool(strings.ToLower(v))
+		if err != nil {
+			return fmt.Errorf("%s: invalid boolean %q", key, v)
+		}
+		*dst = b
+		return nil
+	}
+	integer := func(key string, dst *int) error {
+		v, ok := values[key]
+		if !ok || v == "" {
+			return nil
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%s: invalid number %q", key, v)
+		}
+		*dst = n
+		return nil
+	}
+
+	// Operation
+	switch strings.ToLower(values["operationtype"]) {
+	case "deletedatabase":
+		config.Operation = model.OperationDelete
+	case "", "createdatabase":
+		config.Operation = model.OperationCreate
+	case "createpluggabledatabase":
+		config.Operation = model.OperationCreatePDB
+	case "deletepluggabledatabase":
+		config.Operation = model.OperationDeletePDB
+	case "unplugdatabase":
+		config.Operation = model.OperationUnplugPDB
+	case "plugdatabase":
+		config.Operation = model.OperationPlugPDB
+	case "relocatepdb":
+		config.Operation = model.OperationRelocatePDB
+	case "configuredatabase":
+		config.Operation = model.OperationConfigure
+	case "createtemplatefromdb", "createclonetemplate":
+		config.Operation = model.OperationTemplate
+	case "createduplicatedb":
+		config.Operation = model.OperationDuplicate
+	case "addinstance":
+		config.Operation = model.OperationAddInstance
+	case "deleteinstance":
+		config.Operation = model.OperationDeleteInstance
+	default:
+		return fmt.Errorf("operationType: unsupported operation %q", values["operationtype"])
+	}
+	if values["operationtype"] == "" && values["sourcedb"] != "" && values["gdbname"] == "" {
+		// 12.2+ files carry no operation type; a bare sourceDB means delete
+		// unless the file names a pluggable database, a template or options to configure
+		switch {
+		case values["pdbname"] != "":
+			config.Operation = detectPDBOperation(values)
+		case values["templatename"] != "":
+			config.Operation = model.OperationTemplate
+		case hasAnyKey(values, "enablearchive", "emconfiguration", "dvconfiguration", "olsconfiguration"):
+			config.Operation = model.OperationConfigure
+		default:
+			config.Operation = model.OperationDelete
+		}
+	}
+	if values["operationtype"] == "" && values["sourcesid"] != "" && values["templatename"] != "" {
+		// A clone template is taken from a local database named by its SID
+		config.Operation = model.OperationTemplate
+	}
+	if values["operationtype"] == "" && values["primarydbconnectionstring"] != "" {
+		config.Operation = model.OperationDuplicate
+	}
+	if values["operationtype"] == "" && values["nodename"] != "" {
+		// Without an operation type the non-destructive one is assumed
+		config.Operation = model.OperationAddInstance
+	}
+
+	// Target release from the schema version, e.g. ..._schema_v19.0.0
+	if v := values["responsefileversion"]; v != "" {
+		config.TargetVersion = versionFromSchema(v)
+	}
+
+	// Database identification
+	str("gdbname", &config.GlobalDBName)
+	str("sid", &config.SID)
+	if values["sid"] == "" && values["gdbname"] != "" {
+		config.SID, _, _ = strings.Cut(config.GlobalDBName, ".")
+	}
+
+	// Deployment type
+	if v := strings.ToUpper(values["databaseconfigtype"]); v != "" {
+		switch model.DeploymentType(v) {
+		case model.DeploymentSingleInstance, model.DeploymentRAC, model.DeploymentRACOneNode:
+			config.DeploymentType = model.DeploymentType(v)
+		default:
+			return fmt.Errorf("databaseConfigType: unsupported value %q", values["databaseconfigtype"])
+		}
+	}
+	str("nodelist", &config.NodeList)
+
+	// Container database settings
+	if err := boolean("createascontainerdatabase", &config.CreateAsContainerDB); err != nil {
+		return err
+	}
+	if err := integer("numberofpdbs", &config.NumberOfPDBs); err != nil {
+		return err
+	}
+	str("pdbname", &config.PDBName)
+	config.PDBPrefix = config.PDBName
+	if err := boolean("uselocalundoforpdbs", &config.UseLocalUndoForPDBs); err != nil {
+		return err
+	}
+	if !config.CreateAsContainerDB {
+		config.NumberOfPDBs = 0
+		config.PDBName = ""
+		config.PDBPrefix = ""
+	}
+
+	// Template
+	if v, ok := values["templatename"]; ok {
+		switch {
+		case v == "":
+			config.TemplateName = model.TemplateCustom
+		case strings.HasPrefix(v, "General_Purpose"):
+			config.TemplateName = model.TemplateGeneralPurpose
+		case strings.HasPrefix(v, "Data_Warehouse"):
+			config.TemplateName = model.TemplateDataWarehouse
+		default:
+			config.TemplateName = model.DatabaseTemplate(v)
+		}
+	}
+	if v := strings.ToUpper(values["databasetype"]); v != "" {
+		config.DatabaseType = model.DatabaseType(v)
+	} else if config.TemplateName == model.TemplateDataWarehouse {
+		config.DatabaseType = model.DatabaseTypeDataWarehouse
+	}
+
+	// Passwords
+	str("syspassword", &config.SysPassword)
+	str("systempassword", &config.SystemPassword)
+	str("pdbadminpassword", &config.PDBAdminPassword)
+	str("sysdbapassword", &config.SysPassword)
+	if err := boolean("usewalletfordbcredentials", &config.UseWallet); err != nil {
+		return err
+	}
+	str("dbcredentialswalletlocation", &config.WalletLocation)
+	// Without SYSDBA credentials DBCA connects with operating system authentication
+	_, hasSysDBAUser := values["sysdbausername"]
+	config.UseOSAuthentication = !hasSysDBAUser && values["sysdbapassword"] == "" && !config.UseWallet
+	config.UseCommonPassword = config.SysPassword == config.SystemPassword &&
+		(config.PDBAdminPassword == "" || config.PDBAdminPassword == config.SysPassword)
+	if config.UseCommonPassword {
+		config.CommonPassword = config.SysPassword
+	}
+
+	// Enterprise Manager configuration
+	switch strings.ToUpper(values["emconfiguration"]) {
+	case "":
+	case "NONE":
+		config.EMConfiguration = model.EMConfigNone
+	case "DBEXPRESS", "LOCAL":
+		config.EMConfiguration = model.EMConfigDBExpress
+	case "CENTRAL", "BOTH":
+		config.EMConfiguration = model.EMConfigCentral
+	default:
+		return fmt.Errorf("emConfiguration: unsupported value %q", values["emconfiguration"])
+	}
+	if err := integer("emexpressport", &config.EMPort); err != nil {
+		return err
+	}
+	str("omshost", &config.CloudControlAgent)
+	if config.EMConfiguration == model.EMConfigCentral {
+		if err := integer("omsport", &config.EMPort); err != nil {
+			return err
+		}
+	}
+
+	// Data Vault
+	if err := boolean("dvconfiguration", &config.EnableDataVault); err != nil {
+		return err
+	}
+	str("dvusername", &config.DataVaultOwner)
+	str("dvaccountmanagername", &config.DataVaultAccountManager)
+	str("dvuserpassword", &config.DataVaultOwnerPassword)
+	str("dvaccountmanagerpassword", &config.DataVaultAccountManagerPassword)
+	if err := boolean("olsconfiguration", &config.EnableLabelSecurity); err != nil {
+		return err
+	}
+
+	// Storage configuration
+	if v := strings.ToUpper(values["storagetype"]); v != "" {
+		switch model.StorageType(v) {
+		case model.StorageTypeFS, model.StorageTypeASM:
+			config.StorageType = model.StorageType(v)
+		default:
+			return fmt.Errorf("storageType: unsupported value %q", values["storagetype"])
+		}
+	}
+	str("diskgroupname", &config.ASMDiskGroup)
+	str("datafiledestination", &config.DatafileDestination)
+	if config.StorageType == model.StorageTypeASM && config.ASMDiskGroup != "" {
+		config.DatafileDestination = config.ASMDiskGroup
+		config.RedoLogDestination = config.ASMDiskGroup
+	}
+	if err := boolean("useomf", &config.UseOMF); err != nil {
+		return err
+	}
+	if err := integer("redologfilesize", &config.RedoLogFileSize); err != nil {
+		return err
+	}
+
+	// Fast Recovery Area and archiving
+	if v, ok := values["recoveryareadestination"]; ok {
+		config.EnableFRA = v != ""
+		config.FRADestination = v
+	}
+	if err := integer("recoveryareasize", &config.FRASize); err != nil {
+		return err
+	}
+	if err := boolean("enablearchive", &config.EnableArchiveLog); err != nil {
+		return err
+	}
+
+	// Character sets
+	str("characterset", &config.CharacterSet)
+	str("nationalcharacterset", &config.NationalCharacterSet)
+
+	// Listener configuration
+	if v := values["listeners"]; v != "" {
+		config.ListenerName, _, _ = strings.Cut(v, ",")
+	}
+
+	// Initialization parameters and sample schemas
+	for _, param := range splitInitParams(values["initparams"]) {
+		name, value, found := strings.Cut(param, "=")
+		if !found {
+			return fmt.Errorf("initParams: expected name=value, got %q", param)
+		}
+		config.InitParams[strings.TrimSpace(name)] = strings.TrimSpace(value)
+	}
+	if err := boolean("sampleschema", &config.EnableSampleSchemas); err != nil {
+		return err
+	}
+
+	// Memory configuration
+	if v := values["automaticmemorymanagement"]; v != "" {
+		amm := false
+		if err := boolean("automaticmemorymanagement", &amm); err != nil {
+			return err
+		}
+		if amm {
+			config.MemoryManagement = "AUTO"
+		} else {
+			config.MemoryManagement = "AUTO_SGA"
+		}
+	}
+	if err := integer("totalmemory", &config.TotalMemory); err != nil {
+		return err
+	}
+	if config.MemoryManagement != "AUTO" {
+		if err := extractMemoryParams(config); err != nil {
+			return err
+		}
+	}
+
+	// Delete options
+	str("sourcedb", &config.DeleteSID)
+	if err := boolean("forcearchivelogdeletion", &config.DeleteForce); err != nil {
+		return err
+	}
+
+	switch config.Operation {
+	case model.OperationConfigure:
+		config.ConfigureSID = config.DeleteSID
+		config.DeleteSID = ""
+	case model.OperationTemplate:
+		applyTemplateValues(config, values)
+	case model.OperationDuplicate:
+		return applyDuplicateValues(config, values)
+	case model.OperationAddInstance, model.OperationDeleteInstance:
+		config.InstanceNode = values["nodename"]
+		config.InstanceName = values["instancename"]
+	case model.OperationCreatePDB:
+		return applyCreatePDBValues(config, values)
+	case model.OperationDeletePDB, model.OperationUnplugPDB, model.OperationPlugPDB:
+		return applyPDBValues(config, values)
+	case model.OperationRelocatePDB:
+		applyRelocatePDBValues(config, values)
+	}
+
+	return nil
+}
+
+// applyTemplateValues maps the create template keys, which share their names
+// with the create and delete keys, onto the template options
+func applyTemplateValues(config *model.DBConfig, values map[string]string) {
+	config.TemplateSourceDB = config.DeleteSID
+	config.DeleteSID = ""
+	config.NewTemplateName = values["templatename"]
+	config.TemplateName = model.NewDBConfig().TemplateName
+
+	// Without an operation type, a clone template is recognized by its
+	// sourceSID key, or by its source being a local SID rather than a connect string
+	if sid := values["sourcesid"]; sid != "" {
+		config.TemplateSourceDB = sid
+	}
+	switch strings.ToLower(values["operationtype"]) {
+	case "createclonetemplate":
+		config.TemplateIncludeDatafiles = true
+	case "":
+		config.TemplateIncludeDatafiles = values["sourcesid"] != "" || !strings.ContainsAny(config.TemplateSourceDB, ":/")
+	}
+}
+
+// applyDuplicateValues maps the duplicate database keys onto the config
+func applyDuplicateValues(config *model.DBConfig, values map[string]string) error {
+	if v := values["primarydbconnectionstring"]; v != "" {
+		config.PrimaryConnectString = v
+	}
+	if v := values["createasstandby"]; v != "" {
+		b, err := strconv.ParseBool(strings.ToLower(v))
+		if err != nil {
+			return fmt.Errorf("createasstandby: invalid boolean %q", v)
+		}
+		config.CreateAsStandby = b
+	}
+	if v := values["dbuniquename"]; v != "" {
+		config.StandbyUniqueName = v
+	}
+
+	// A standby keeps the DB_NAME of its primary
+	config.PrimaryDBName, _, _ = strings.Cut(config.GlobalDBName, ".")
+
+	// ASM takes the disk group as the datafile destination
+	if config.StorageType == model.StorageTypeASM && strings.HasPrefix(config.DatafileDestination, "+") {
+		config.ASMDiskGroup = config.DatafileDestination
+	}
+
+	// A new listener is given as name:port
+	if v := values["createlistener"]; v != "" {
+		name, port, _ := strings.Cut(v, ":")
+		n, err := strconv.Atoi(port)
+		if err != nil {
+			return fmt.Errorf("createListener: expected name:port, got %q", v)
+		}
+		config.CreateNewListener = true
+		config.ListenerName = name
+		config.ListenerPort = n
+	}
+
+	return nil
+}
+
+// detectPDBOperation tells the pluggable database operations apart by the
+// keys only they use
+func detectPDBOperation(values map[string]string) model.Operation {
+	switch {
+	case hasAnyKey(values, "remotepdbname", "remotedbconnstring"):
+		return model.OperationRelocatePDB
+	case hasAnyKey(values, "archivetype"):
+		return model.OperationUnplugPDB
+	case hasAnyKey(values, "createpdbfrom", "sourcepdb", "pdbadminusername", "createnewpdbadminuser"):
+		return model.OperationCreatePDB
+	case hasAnyKey(values, "pdbarchivefile", "pdbbackupfile", "pdbmetadatafile", "createasclone", "copypdbfiles"):
+		return model.OperationPlugPDB
+	default:
+		return model.OperationDeletePDB
+	}
+}
+
+// hasAnyKey returns true if any of the normalized keys is present
+func hasAnyKey(values map[string]string, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := values[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// applyPDBValues maps the delete, unplug and plug pluggable database keys onto the config
+func applyPDBValues(config *model.DBConfig, values map[string]string) error {
+	config.SourceCDB = values["sourcedb"]
+	config.DeleteSID = ""
+	if config.Operation == model.OperationPlugPDB {
+		config.NewPDBName = values["pdbname"]
+	} else {
+		config.TargetPDB = values["pdbname"]
+	}
+
+	config.PDBArchiveFile = values["pdbarchivefile"]
+	config.PDBBackupFile = values["pdbbackupfile"]
+	config.PDBMetadataFile = values["pdbmetadatafile"]
+	switch strings.ToUpper(values["archivetype"]) {
+	case "TAR":
+		config.PDBArchiveType = model.PDBArchiveTAR
+	case "RMAN":
+		config.PDBArchiveType = model.PDBArchiveRMAN
+	case "NONE":
+		config.PDBArchiveType = model.PDBArchiveNone
+	case "":
+		// Plug files carry no archive type; infer it from the files given
+		switch {
+		case config.PDBArchiveFile != "":
+			config.PDBArchiveType = model.PDBArchiveTAR
+		case config.PDBBackupFile != "":
+			config.PDBArchiveType = model.PDBArchiveRMAN
+		case config.PDBMetadataFile != "":
+			config.PDBArchiveType = model.PDBArchiveNone
+		}
+	default:
+		return fmt.Errorf("archiveType: unsupported value %q", values["archivetype"])
+	}
+
+	for key, dst := range map[string]*bool{
+		"createasclone": &config.PDBCreateAsClone,
+		"copypdbfiles":  &config.PDBCopyFiles,
+	} {
+		if v := values[key]; v != "" {
+			b, err := strconv.ParseBool(strings.ToLower(v))
+			if err != nil {
+				return fmt.Errorf("%s: invalid boolean %q", key, v)
+			}
+			*dst = b
+		}
+	}
+	config.PDBSourceFileNameConvert = values["sourcefilenameconvert"]
+	config.PDBFileNameConvert = values["filenameconvert"]
+
+	return nil
+}
+
+// applyRelocatePDBValues maps the relocate pluggable database keys onto the config
+func applyRelocatePDBValues(config *model.DBConfig, values map[string]string) {
+	config.SourceCDB = values["sourcedb"]
+	config.DeleteSID = ""
+	config.NewPDBName = values["pdbname"]
+	config.TargetPDB = values["remotepdbname"]
+	config.RemoteCDBConnectString = values["remotedbconnstring"]
+	if v := values["remotedbsysdbausername"]; v != "" {
+		config.RemoteSysDBAUser = v
+	}
+	config.RemoteSysDBAPassword = values["remotedbsysdbauserpassword"]
+	config.DBLinkUser = values["dblinkusername"]
+	config.DBLinkPassword = values["dblinkuserpassword"]
+}
+
+// applyCreatePDBValues maps the create pluggable database keys onto the config
+func applyCreatePDBValues(config *model.DBConfig, values map[string]string) error {
+	config.SourceCDB = values["sourcedb"]
+	config.DeleteSID = ""
+	config.NewPDBName = values["pdbname"]
+	config.SourcePDB = values["sourcepdb"]
+	config.PDBMetadataFile = values["pdbmetadatafile"]
+
+	switch strings.ToUpper(values["createpdbfrom"]) {
+	case "USINGXML":
+		config.PDBSource = model.PDBSourceXML
+	case "", "DEFAULT":
+		config.PDBSource = model.PDBSourceSeed
+		if config.SourcePDB != "" {
+			config.PDBSource = model.PDBSourceClone
+		}
+	default:
+		return fmt.Errorf("createPDBFrom: unsupported value %q", values["createpdbfrom"])
+	}
+
+	// An explicit convert list or XML file locations mean no OMF placement
+	config.PDBFileNameConvert = values["filenameconvert"]
+	config.PDBDatafileDestination = values["pdbdatafiledestination"]
+	useMetadataLocation := strings.EqualFold(values["usemetadatafilelocation"], "true")
+	config.PDBUseOMF = config.PDBFileNameConvert == "" && !useMetadataLocation
+
+	if v := values["pdbadminusername"]; v != "" {
+		config.PDBAdminUser = v
+	}
+
+	return nil
+}
+
+// normalizeKey folds the 19c camelCase and legacy UPPER_CASE spellings together
+func normalizeKey(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", ""))
+}
+
+// unquote strips the double quotes legacy response files put around values
+func unquote(value string) string {
+	if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// splitInitParams splits a comma-separated initParams value, keeping
+// commas inside quotes or parentheses together
+func splitInitParams(value string) []string {
+	var params []string
+	var current strings.Builder
+	depth := 0
+	var quote rune
+
+	for _, r := range value {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')':
+			if depth > 0 {
+				depth--
+			}
+		case r == ',' && depth == 0:
+			if p := strings.TrimSpace(current.String()); p != "" {
+				params = append(params, p)
+			}
+			current.Reset()
+			continue
+		}
+		current.WriteRune(r)
+	}
+	if p := strings.TrimSpace(current.String()); p != "" {
+		params = append(params, p)
+	}
+
+	return params
+}
+
+// versionFromSchema maps a responseFileVersion value to the target release.
+// Schemas older than 12.2 and unknown ones fall back to 19c.
+func versionFromSchema(schema string) model.OracleVersion {
+	_, v, found := strings.Cut(strings.ToLower(schema), "_schema_v")
+	if !found {
+		return model.Version19c
+	}
+	switch {
+	case strings.HasPrefix(v, "12.2"):
+		return model.Version122
+	case strings.HasPrefix(v, "21."):
+		return model.Version21c
+	case strings.HasPrefix(v, "23."):
+		return model.Version23ai
+	default:
+		return model.Version19c
+	}
+}
+
+// extractMemoryParams moves the SGA/PGA sizing init parameters into the
+// dedicated config fields. sga_max_size implies manual memory management.
+func extractMemoryParams(config *model.DBConfig) error {
+	sgaTarget := &config.SGASize
+	if _, ok := config.InitParams["sga_max_size"]; ok {
+		config.MemoryManagement = "MANUAL"
+		sgaTarget = &config.SGATarget
+	}
+
+	fields := map[string]*int{
+		"sga_max_size":         &config.SGASize,
+		"sga_target":           sgaTarget,
+		"pga_aggregate_target": &config.PGASize,
+		"pga_aggregate_limit":  &config.PGAAggregateLimit,
+	}
+	for name, dst := range fields {
+		v, ok := config.InitParams[name]
+		if !ok {
+			continue
+		}
+		mb, err := parseMegabytes(v)
+		if err != nil {
+			return fmt.Errorf("initParams: %s: %w", name, err)
+		}
+		*dst = mb
+		delete(config.InitParams, name)
+	}
+	return nil
+}
+
+// parseMegabytes converts an Oracle size value (e.g. 4G, 512M or plain bytes) to MB
+func parseMegabytes(value string) (int, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return 0, fmt.Errorf("empty size")
+	}
+
+	var bytesPerUnit int64 = 1
+	switch value[len(value)-1] {
+	case 'K':
+		bytesPerUnit = 1 << 10
+	case 'M':
+		bytesPerUnit = 1 << 20
+	case 'G':
+		bytesPerUnit = 1 << 30
+	case 'T':
+		bytesPerUnit = 1 << 40
+	}
+	if bytesPerUnit > 1 {
+		value = value[:len(value)-1]
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+	return int(n * bytesPerUnit >> 20), nil
+}
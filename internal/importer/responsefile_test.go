@@ -0,0 +1,261 @@
+package importer
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"dbca_tui/internal/generator"
+	"dbca_tui/internal/model"
+)
+
+func TestResponseFileRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		edit  func(c *model.DBConfig)
+		check func(got, want *model.DBConfig) bool
+	}{
+		{
+			name: "create",
+			edit: func(c *model.DBConfig) {
+				c.GlobalDBName = "sales.example.com"
+				c.SID = "sales"
+				c.SysPassword = "Sys_Pwd1"
+				c.SystemPassword = "System_Pwd1"
+				c.PDBAdminPassword = "Pdb_Pwd1"
+				c.UseCommonPassword = false
+				c.EnableArchiveLog = true
+				c.CharacterSet = "WE8MSWIN1252"
+				c.MemoryManagement = "AUTO_SGA"
+				c.SGASize = 1536
+				c.PGASize = 512
+				c.InitParams["processes"] = "600"
+				c.InitParams["audit_trail"] = "'DB,EXTENDED'"
+			},
+			check: func(got, want *model.DBConfig) bool {
+				return got.Operation == want.Operation &&
+					got.GlobalDBName == want.GlobalDBName &&
+					got.SID == want.SID &&
+					got.SysPassword == want.SysPassword &&
+					got.SystemPassword == want.SystemPassword &&
+					got.PDBAdminPassword == want.PDBAdminPassword &&
+					got.UseCommonPassword == want.UseCommonPassword &&
+					got.EnableArchiveLog == want.EnableArchiveLog &&
+					got.CharacterSet == want.CharacterSet &&
+					got.MemoryManagement == want.MemoryManagement &&
+					got.SGASize == want.SGASize &&
+					got.PGASize == want.PGASize &&
+					maps.Equal(got.InitParams, want.InitParams)
+			},
+		},
+		{
+			name: "delete",
+			edit: func(c *model.DBConfig) {
+				c.Operation = model.OperationDelete
+				c.DeleteSID = "sales"
+				c.SysPassword = "Sys_Pwd1"
+				c.DeleteForce = true
+			},
+			check: func(got, want *model.DBConfig) bool {
+				return got.Operation == want.Operation &&
+					got.DeleteSID == want.DeleteSID &&
+					got.SysPassword == want.SysPassword &&
+					got.DeleteForce == want.DeleteForce
+			},
+		},
+		{
+			name: "unplug PDB",
+			edit: func(c *model.DBConfig) {
+				c.Operation = model.OperationUnplugPDB
+				c.SourceCDB = "cdb1"
+				c.TargetPDB = "pdb1"
+				c.PDBArchiveType = model.PDBArchiveRMAN
+				c.PDBBackupFile = "/backup/pdb1.bkp"
+				c.PDBMetadataFile = "/backup/pdb1.xml"
+				c.SysPassword = "Sys_Pwd1"
+			},
+			check: func(got, want *model.DBConfig) bool {
+				return got.Operation == want.Operation &&
+					got.SourceCDB == want.SourceCDB &&
+					got.TargetPDB == want.TargetPDB &&
+					got.PDBArchiveType == want.PDBArchiveType &&
+					got.PDBBackupFile == want.PDBBackupFile &&
+					got.PDBMetadataFile == want.PDBMetadataFile &&
+					got.SysPassword == want.SysPassword
+			},
+		},
+		{
+			name: "delete PDB with operating system authentication",
+			edit: func(c *model.DBConfig) {
+				c.Operation = model.OperationDeletePDB
+				c.SourceCDB = "cdb1"
+				c.TargetPDB = "pdb1"
+				c.UseOSAuthentication = true
+			},
+			check: func(got, want *model.DBConfig) bool {
+				return got.Operation == want.Operation &&
+					got.TargetPDB == want.TargetPDB &&
+					got.UseOSAuthentication == want.UseOSAuthentication &&
+					got.SysPassword == ""
+			},
+		},
+		{
+			name: "relocate PDB",
+			edit: func(c *model.DBConfig) {
+				c.Operation = model.OperationRelocatePDB
+				c.SourceCDB = "cdb2"
+				c.TargetPDB = "pdb1"
+				c.NewPDBName = "pdb1new"
+				c.RemoteCDBConnectString = "host1:1521/cdb1"
+				c.RemoteSysDBAPassword = "Remote_Pwd1"
+				c.DBLinkUser = "c##link"
+				c.DBLinkPassword = "Link_Pwd1"
+				c.SysPassword = "Sys_Pwd1"
+			},
+			check: func(got, want *model.DBConfig) bool {
+				return got.Operation == want.Operation &&
+					got.SourceCDB == want.SourceCDB &&
+					got.TargetPDB == want.TargetPDB &&
+					got.NewPDBName == want.NewPDBName &&
+					got.RemoteCDBConnectString == want.RemoteCDBConnectString &&
+					got.RemoteSysDBAPassword == want.RemoteSysDBAPassword &&
+					got.DBLinkUser == want.DBLinkUser &&
+					got.DBLinkPassword == want.DBLinkPassword
+			},
+		},
+		{
+			name: "preserved keys",
+			edit: func(c *model.DBConfig) {
+				c.SysPassword = "Sys_Pwd1"
+				c.SystemPassword = "Sys_Pwd1"
+				c.ExtraResponseParams["variables"] = "ORACLE_BASE_HOME=/u01/app/oracle/homes/OraDB19Home1"
+			},
+			check: func(got, want *model.DBConfig) bool {
+				return maps.Equal(got.ExtraResponseParams, want.ExtraResponseParams)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		want := model.NewDBConfig()
+		tt.edit(want)
+
+		got, _, err := ParseResponseFile(strings.NewReader(generator.GenerateResponseFileWithPasswords(want)))
+		if err != nil {
+			t.Errorf("%s: ParseResponseFile() error = %v", tt.name, err)
+			continue
+		}
+		if !tt.check(got, want) {
+			t.Errorf("%s: ParseResponseFile() = %+v, want %+v", tt.name, got, want)
+		}
+	}
+}
+
+func TestMaskedResponseFileRoundTrip(t *testing.T) {
+	want := model.NewDBConfig()
+	want.SysPassword = "Sys_Pwd1"
+	want.SystemPassword = "Sys_Pwd1"
+
+	got, _, err := ParseResponseFile(strings.NewReader(generator.GenerateResponseFile(want)))
+	if err != nil {
+		t.Fatalf("ParseResponseFile() error = %v", err)
+	}
+	if got.SysPassword == want.SysPassword {
+		t.Errorf("ParseResponseFile() read the SYS password from a masked response file")
+	}
+	if got.GlobalDBName != want.GlobalDBName || got.SID != want.SID {
+		t.Errorf("ParseResponseFile() = %s/%s, want %s/%s", got.GlobalDBName, got.SID, want.GlobalDBName, want.SID)
+	}
+}
+
+func TestParseResponseFileKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantUnknown []string
+		wantExtra   map[string]string
+		check       func(c *model.DBConfig) bool
+	}{
+		{
+			name:        "unknown keys are sorted and preserved",
+			input:       "gdbName=orcl\nzKey=1\naKey=2\n",
+			wantUnknown: []string{"aKey", "zKey"},
+			wantExtra:   map[string]string{"aKey": "2", "zKey": "1"},
+		},
+		{
+			name:        "duplicate unknown key keeps its last value",
+			input:       "gdbName=orcl\nvariables=A=1\nvariables=A=2\n",
+			wantUnknown: []string{"variables"},
+			wantExtra:   map[string]string{"variables": "A=2"},
+		},
+		{
+			name:      "duplicate known key keeps its last value",
+			input:     "gdbName=orcl\ngdbName=sales\n",
+			wantExtra: map[string]string{},
+			check:     func(c *model.DBConfig) bool { return c.GlobalDBName == "sales" && c.SID == "sales" },
+		},
+		{
+			name:      "legacy key spelling",
+			input:     "[GENERAL]\nRESPONSEFILE_VERSION = \"12.2.0\"\nOPERATION_TYPE = \"createDatabase\"\n[CREATEDATABASE]\nGDBNAME = \"legacy.example.com\"\nSID = \"legacy\"\n",
+			wantExtra: map[string]string{},
+			check:     func(c *model.DBConfig) bool { return c.GlobalDBName == "legacy.example.com" && c.SID == "legacy" },
+		},
+		{
+			name:      "comments and blank lines",
+			input:     "# comment\n\n   \ngdbName=orcl\n",
+			wantExtra: map[string]string{},
+			check:     func(c *model.DBConfig) bool { return c.GlobalDBName == "orcl" },
+		},
+		{
+			name:      "init params with quoted commas",
+			input:     "gdbName=orcl\nautomaticMemoryManagement=false\ninitParams=processes=300,audit_trail='db,extended',sga_target=2G\n",
+			wantExtra: map[string]string{},
+			check: func(c *model.DBConfig) bool {
+				return maps.Equal(c.InitParams, map[string]string{"processes": "300", "audit_trail": "'db,extended'"}) &&
+					c.SGASize == 2048
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, unknown, err := ParseResponseFile(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("%s: ParseResponseFile() error = %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(unknown, tt.wantUnknown) {
+			t.Errorf("%s: unknown keys = %v, want %v", tt.name, unknown, tt.wantUnknown)
+		}
+		if !maps.Equal(got.ExtraResponseParams, tt.wantExtra) {
+			t.Errorf("%s: ExtraResponseParams = %v, want %v", tt.name, got.ExtraResponseParams, tt.wantExtra)
+		}
+		if tt.check != nil && !tt.check(got) {
+			t.Errorf("%s: ParseResponseFile() = %+v", tt.name, got)
+		}
+	}
+}
+
+func TestParseResponseFileErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"line without a value", "gdbName=orcl\nsysPassword\n", `line 2: expected key=value, got "sysPassword"`},
+		{"invalid boolean", "gdbName=orcl\ncreateAsContainerDatabase=maybe\n", `createascontainerdatabase: invalid boolean "maybe"`},
+		{"invalid number", "gdbName=orcl\ntotalMemory=2G\n", `totalmemory: invalid number "2G"`},
+		{"unsupported operation", "operationType=upgradeDatabase\n", `operationType: unsupported operation "upgradeDatabase"`},
+		{"unsupported deployment", "gdbName=orcl\ndatabaseConfigType=GRID\n", `databaseConfigType: unsupported value "GRID"`},
+		{"init param without a value", "gdbName=orcl\ninitParams=processes\n", `initParams: expected name=value, got "processes"`},
+		{"invalid memory size", "gdbName=orcl\nautomaticMemoryManagement=false\ninitParams=sga_target=lots\n", `initParams: sga_target: invalid size "LOTS"`},
+		{"unsupported archive type", "sourceDB=cdb1\npdbName=pdb1\narchiveType=ZIP\n", `archiveType: unsupported value "ZIP"`},
+	}
+
+	for _, tt := range tests {
+		_, _, err := ParseResponseFile(strings.NewReader(tt.input))
+		if err == nil || err.Error() != tt.want {
+			t.Errorf("%s: ParseResponseFile() error = %v, want %s", tt.name, err, tt.want)
+		}
+	}
+}
@@ -1,13 +1,40 @@
 package model
 
+import "strings"
+
 // Operation represents the DBCA operation type
 type Operation string
 
 const (
-	OperationCreate Operation = "create"
-	OperationDelete Operation = "delete"
+	OperationCreate         Operation = "create"
+	OperationDelete         Operation = "delete"
+	OperationCreatePDB      Operation = "createPluggableDatabase"
+	OperationDeletePDB      Operation = "deletePluggableDatabase"
+	OperationUnplugPDB      Operation = "unplugDatabase"
+	OperationPlugPDB        Operation = "plugDatabase"
+	OperationRelocatePDB    Operation = "relocatePDB"
+	OperationConfigure      Operation = "configureDatabase"
+	OperationTemplate       Operation = "createTemplateFromDB"
+	OperationDuplicate      Operation = "createDuplicateDB"
+	OperationAddInstance    Operation = "addInstance"
+	OperationDeleteInstance Operation = "deleteInstance"
 )
 
+// IsPluggable returns true for operations on a pluggable database of an existing CDB
+func (o Operation) IsPluggable() bool {
+	switch o {
+	case OperationCreatePDB, OperationDeletePDB, OperationUnplugPDB, OperationPlugPDB, OperationRelocatePDB:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInstance returns true for operations on an instance of an existing RAC database
+func (o Operation) IsInstance() bool {
+	return o == OperationAddInstance || o == OperationDeleteInstance
+}
+
 // CreationMode represents the database creation mode
 type CreationMode string
 
@@ -34,6 +61,17 @@ const (
 	TemplateCustom         DatabaseTemplate = "Custom"
 )
 
+// IsFile returns true for a template file other than the seed templates,
+// given by name or by path
+func (t DatabaseTemplate) IsFile() bool {
+	switch t {
+	case TemplateGeneralPurpose, TemplateDataWarehouse, TemplateCustom:
+		return false
+	default:
+		return true
+	}
+}
+
 // StorageType represents the storage type
 type StorageType string
 
@@ -46,104 +84,192 @@ const (
 type EMConfiguration string
 
 const (
-	EMConfigNone     EMConfiguration = "NONE"
+	EMConfigNone      EMConfiguration = "NONE"
 	EMConfigDBExpress EMConfiguration = "DBEXPRESS"
-	EMConfigCentral  EMConfiguration = "CENTRAL"
+	EMConfigCentral   EMConfiguration = "CENTRAL"
 )
 
 // DatabaseType represents the database workload type
 type DatabaseType string
 
 const (
-	DatabaseTypeMultipurpose DatabaseType = "MULTIPURPOSE"
+	DatabaseTypeMultipurpose  DatabaseType = "MULTIPURPOSE"
 	DatabaseTypeDataWarehouse DatabaseType = "DATA_WAREHOUSING"
-	DatabaseTypeOLTP         DatabaseType = "OLTP"
+	DatabaseTypeOLTP          DatabaseType = "OLTP"
+)
+
+// PDBSource represents where a new pluggable database comes from
+type PDBSource string
+
+const (
+	PDBSourceSeed  PDBSource = "SEED"  // Create from PDB$SEED
+	PDBSourceClone PDBSource = "CLONE" // Clone a PDB in the same CDB
+	PDBSourceXML   PDBSource = "XML"   // Plug in using an XML metadata file
+)
+
+// PDBArchiveType represents how an unplugged pluggable database is stored
+type PDBArchiveType string
+
+const (
+	PDBArchiveTAR  PDBArchiveType = "TAR"  // Single archive with the XML file and datafiles
+	PDBArchiveRMAN PDBArchiveType = "RMAN" // RMAN backup plus an XML metadata file
+	PDBArchiveNone PDBArchiveType = "NONE" // XML metadata file only; datafiles stay in place
 )
 
 // DBConfig holds all database configuration options
 type DBConfig struct {
 	// Operation type
-	Operation Operation
+	Operation Operation `json:"operation"`
+
+	// Target Oracle release
+	TargetVersion OracleVersion `json:"targetVersion"`
+
+	// Oracle home whose bin/dbca runs the command; empty runs dbca from the PATH
+	OracleHome string `json:"oracleHome"`
 
 	// Step 1: Creation Mode (for create operation)
-	CreationMode CreationMode
+	CreationMode CreationMode `json:"creationMode"`
 
 	// Step 2: Deployment Type
-	DeploymentType DeploymentType
-	NodeList       string // Comma-separated list for RAC
+	DeploymentType DeploymentType `json:"deploymentType"`
+	NodeList       string         `json:"nodeList"` // Comma-separated list for RAC; the known cluster nodes for instance operations
 
 	// Step 3: Template
-	TemplateName DatabaseTemplate
-	DatabaseType DatabaseType
+	TemplateName       DatabaseTemplate  `json:"templateName"`
+	DatabaseType       DatabaseType      `json:"databaseType"`
+	TemplateDefaults   string            `json:"templateDefaults,omitempty"`   // Template whose defaults were applied; they are not applied again
+	TemplateInitParams map[string]string `json:"templateInitParams,omitempty"` // Init parameters copied from that template
 
 	// Step 4: Database Identification
-	GlobalDBName          string
-	SID                   string
-	CreateAsContainerDB   bool
-	NumberOfPDBs          int
-	PDBName               string
-	PDBPrefix             string
+	GlobalDBName        string `json:"globalDBName"`
+	SID                 string `json:"sid"`
+	CreateAsContainerDB bool   `json:"createAsContainerDB"`
+	NumberOfPDBs        int    `json:"numberOfPDBs"`
+	PDBName             string `json:"pdbName"`
+	PDBPrefix           string `json:"pdbPrefix"`
+	UseLocalUndoForPDBs bool   `json:"useLocalUndoForPDBs"`
 
 	// Step 5: Storage
-	StorageType              StorageType
-	DatafileDestination      string
-	RedoLogDestination       string
-	ASMDiskGroup             string
-	UseOMF                   bool // Oracle Managed Files
+	StorageType         StorageType `json:"storageType"`
+	DatafileDestination string      `json:"datafileDestination"`
+	RedoLogDestination  string      `json:"redoLogDestination"`
+	ASMDiskGroup        string      `json:"asmDiskGroup"`
+	UseOMF              bool        `json:"useOMF"` // Oracle Managed Files
 
 	// Step 6: Fast Recovery Area
-	EnableFRA                bool
-	FRADestination           string
-	FRASize                  int // In MB
-	EnableArchiveLog         bool
+	EnableFRA        bool   `json:"enableFRA"`
+	FRADestination   string `json:"fraDestination"`
+	FRASize          int    `json:"fraSize"` // In MB
+	EnableArchiveLog bool   `json:"enableArchiveLog"`
 
 	// Step 7: Network
-	ListenerName             string
-	ListenerPort             int
-	CreateNewListener        bool
+	ListenerName      string `json:"listenerName"`
+	ListenerPort      int    `json:"listenerPort"`
+	CreateNewListener bool   `json:"createNewListener"`
 
 	// Step 8: Data Vault (Advanced only)
-	EnableDataVault          bool
-	DataVaultOwner           string
-	DataVaultAccountManager  string
+	EnableDataVault                 bool   `json:"enableDataVault"`
+	DataVaultOwner                  string `json:"dataVaultOwner"`
+	DataVaultAccountManager         string `json:"dataVaultAccountManager"`
+	DataVaultOwnerPassword          string `json:"dataVaultOwnerPassword,omitempty"`          // Configure only
+	DataVaultAccountManagerPassword string `json:"dataVaultAccountManagerPassword,omitempty"` // Configure only
+	EnableLabelSecurity             bool   `json:"enableLabelSecurity"`
 
 	// Step 9: Configuration Options
-	MemoryManagement         string // AUTO_SGA, MANUAL, AUTO
-	TotalMemory              int    // In MB
-	SGASize                  int    // In MB
-	PGASize                  int    // In MB
-	CharacterSet             string
-	NationalCharacterSet     string
-	ConnectionMode           string // DEDICATED, SHARED
-	EnableSampleSchemas      bool
+	MemoryManagement     string `json:"memoryManagement"`  // AUTO_SGA, MANUAL, AUTO
+	TotalMemory          int    `json:"totalMemory"`       // In MB
+	SGASize              int    `json:"sgaSize"`           // In MB; sga_target (AUTO_SGA) or sga_max_size (MANUAL)
+	SGATarget            int    `json:"sgaTarget"`         // In MB; MANUAL only, 0 sizes SGA components manually
+	PGASize              int    `json:"pgaSize"`           // In MB; pga_aggregate_target
+	PGAAggregateLimit    int    `json:"pgaAggregateLimit"` // In MB; 0 keeps the Oracle default
+	CharacterSet         string `json:"characterSet"`
+	NationalCharacterSet string `json:"nationalCharacterSet"`
+	ConnectionMode       string `json:"connectionMode"` // DEDICATED, SHARED
+	EnableSampleSchemas  bool   `json:"enableSampleSchemas"`
 
 	// Step 10: Management Options
-	EMConfiguration          EMConfiguration
-	EMPort                   int
-	CloudControlAgent        string
+	EMConfiguration   EMConfiguration `json:"emConfiguration"`
+	EMPort            int             `json:"emPort"`
+	CloudControlAgent string          `json:"cloudControlAgent"`
 
 	// Step 11: Credentials
-	UseCommonPassword        bool
-	CommonPassword           string
-	SysPassword              string
-	SystemPassword           string
-	PDBAdminPassword         string
+	UseCommonPassword   bool   `json:"useCommonPassword"`
+	CommonPassword      string `json:"commonPassword,omitempty"`
+	SysPassword         string `json:"sysPassword,omitempty"`
+	SystemPassword      string `json:"systemPassword,omitempty"`
+	PDBAdminPassword    string `json:"pdbAdminPassword,omitempty"`
+	UseWallet           bool   `json:"useWallet"`                // SYS credentials come from an Oracle wallet
+	WalletLocation      string `json:"walletLocation,omitempty"` // Directory holding the wallet files
+	WalletAlias         string `json:"walletAlias,omitempty"`    // Connect string the credential is stored under
+	UseOSAuthentication bool   `json:"useOSAuthentication"`      // SYSDBA connects as the operating system user instead of with the SYS password
+	PasswordPolicy      string `json:"passwordPolicy,omitempty"` // ora12c or ora12c_strong; empty selects ora12c
 
 	// Additional Options
-	RedoLogFileSize          int  // In MB
-	IgnorePreReqs            bool
-	InitParams               map[string]string
+	RedoLogFileSize int               `json:"redoLogFileSize"` // In MB
+	IgnorePreReqs   bool              `json:"ignorePreReqs"`
+	InitParams      map[string]string `json:"initParams,omitempty"`
+
+	// Response file keys the wizard does not map, preserved on export
+	ExtraResponseParams map[string]string `json:"extraResponseParams,omitempty"`
 
 	// Delete Operation Options
-	DeleteSID                string
-	DeleteForce              bool  // Force delete even if database is running
-	DeleteExpressMode        bool  // Express mode (no prompts)
+	DeleteSID         string `json:"deleteSID"`
+	DeleteForce       bool   `json:"deleteForce"`       // Force delete even if database is running
+	DeleteExpressMode bool   `json:"deleteExpressMode"` // Express mode (no prompts)
+
+	// Pluggable Database Operation Options
+	SourceCDB              string    `json:"sourceCDB"` // SID of the container database
+	NewPDBName             string    `json:"newPDBName"`
+	PDBSource              PDBSource `json:"pdbSource"`
+	SourcePDB              string    `json:"sourcePDB"`              // PDB to clone
+	PDBMetadataFile        string    `json:"pdbMetadataFile"`        // XML file to plug in
+	PDBFileNameConvert     string    `json:"pdbFileNameConvert"`     // Pairs such as '/pdbseed/','/newpdb/'
+	PDBUseOMF              bool      `json:"pdbUseOMF"`              // Let Oracle Managed Files place the datafiles
+	PDBDatafileDestination string    `json:"pdbDatafileDestination"` // OMF destination, empty uses db_create_file_dest
+	PDBAdminUser           string    `json:"pdbAdminUser"`
+
+	// Configure Database Options
+	ConfigureSID      string           `json:"configureSID"`
+	ConfigureBaseline *DatabaseOptions `json:"configureBaseline,omitempty"` // Current state of the database; nil means a fresh default database
+
+	// Create Template Options
+	TemplateSourceDB         string `json:"templateSourceDB"` // host:port:SID or EZCONNECT; SID of a local database for a clone template
+	NewTemplateName          string `json:"newTemplateName"`
+	TemplateIncludeDatafiles bool   `json:"templateIncludeDatafiles"` // Clone template with the datafiles instead of the structure only
+
+	// Duplicate Database Options
+	PrimaryConnectString string `json:"primaryConnectString"` // EZCONNECT string of the primary, host:port/service
+	PrimaryDBName        string `json:"primaryDBName"`        // db_name of the primary
+	CreateAsStandby      bool   `json:"createAsStandby"`      // Physical standby instead of an independent copy
+	StandbyUniqueName    string `json:"standbyUniqueName"`    // db_unique_name of the standby
+
+	// Add and Delete Instance Options
+	InstanceNode string `json:"instanceNode"` // Cluster node the instance runs on
+	InstanceName string `json:"instanceName"` // Empty lets DBCA name a new instance
+
+	// Delete, Unplug and Plug Pluggable Database Options
+	TargetPDB                string         `json:"targetPDB"` // Existing PDB to delete or unplug
+	PDBArchiveType           PDBArchiveType `json:"pdbArchiveType"`
+	PDBArchiveFile           string         `json:"pdbArchiveFile"`           // TAR archive
+	PDBBackupFile            string         `json:"pdbBackupFile"`            // RMAN backup piece
+	PDBCreateAsClone         bool           `json:"pdbCreateAsClone"`         // Plug in with a new GUID and DBID
+	PDBCopyFiles             bool           `json:"pdbCopyFiles"`             // Copy the datafiles instead of using them in place
+	PDBSourceFileNameConvert string         `json:"pdbSourceFileNameConvert"` // Pairs remapping the locations recorded in the XML file
+
+	// Relocate Pluggable Database Options; the PDB moves from the remote CDB
+	// into SourceCDB, the local CDB DBCA runs against
+	RemoteCDBConnectString string `json:"remoteCDBConnectString"` // EZCONNECT string of the CDB the PDB leaves
+	RemoteSysDBAUser       string `json:"remoteSysDBAUser"`
+	RemoteSysDBAPassword   string `json:"remoteSysDBAPassword,omitempty"`
+	DBLinkUser             string `json:"dbLinkUser"` // Common user of the database link to the remote CDB
+	DBLinkPassword         string `json:"dbLinkPassword,omitempty"`
 }
 
 // NewDBConfig creates a new DBConfig with sensible defaults
 func NewDBConfig() *DBConfig {
 	return &DBConfig{
 		Operation:            OperationCreate,
+		TargetVersion:        Version19c,
 		CreationMode:         CreationModeTypical,
 		DeploymentType:       DeploymentSingleInstance,
 		TemplateName:         TemplateGeneralPurpose,
@@ -153,6 +279,7 @@ func NewDBConfig() *DBConfig {
 		CreateAsContainerDB:  true,
 		NumberOfPDBs:         1,
 		PDBName:              "orclpdb",
+		UseLocalUndoForPDBs:  true,
 		StorageType:          StorageTypeFS,
 		DatafileDestination:  "/u01/app/oracle/oradata",
 		UseOMF:               true,
@@ -176,5 +303,115 @@ func NewDBConfig() *DBConfig {
 		RedoLogFileSize:      50,
 		IgnorePreReqs:        false,
 		InitParams:           make(map[string]string),
+		ExtraResponseParams:  make(map[string]string),
+		PDBSource:            PDBSourceSeed,
+		PDBUseOMF:            true,
+		PDBAdminUser:         "pdbadmin",
+		PDBArchiveType:       PDBArchiveTAR,
+		RemoteSysDBAUser:     "SYS",
+	}
+}
+
+// SplitFileNameConvert splits a FILE_NAME_CONVERT list such as
+// '/pdbseed/','/newpdb/' into its unquoted patterns
+func SplitFileNameConvert(value string) []string {
+	var parts []string
+	for _, p := range strings.Split(value, ",") {
+		parts = append(parts, strings.Trim(strings.TrimSpace(p), `'"`))
+	}
+	return parts
+}
+
+// DatabaseOptions holds the options the configure operation can change on an
+// existing database
+type DatabaseOptions struct {
+	EnableArchiveLog    bool            `json:"enableArchiveLog"`
+	EMConfiguration     EMConfiguration `json:"emConfiguration"`
+	EMPort              int             `json:"emPort"`
+	CloudControlAgent   string          `json:"cloudControlAgent"`
+	EnableDataVault     bool            `json:"enableDataVault"`
+	EnableLabelSecurity bool            `json:"enableLabelSecurity"`
+}
+
+// ApplyCommonPassword sets the SYS, SYSTEM and PDB administrator passwords
+// to the common password when one password is used for all accounts
+func (c *DBConfig) ApplyCommonPassword() {
+	if !c.UseCommonPassword || c.CommonPassword == "" {
+		return
+	}
+	c.SysPassword = c.CommonPassword
+	c.SystemPassword = c.CommonPassword
+	c.PDBAdminPassword = c.CommonPassword
+}
+
+// UsesWallet returns true when the SYS credentials are read from an Oracle
+// wallet. Only the operations whose steps offer the wallet use it.
+func (c *DBConfig) UsesWallet() bool {
+	switch c.Operation {
+	case OperationCreate, OperationDelete, OperationConfigure, OperationAddInstance, OperationDeleteInstance:
+		return c.UseWallet
+	default:
+		return false
+	}
+}
+
+// UsesOSAuthentication returns true when DBCA connects as SYSDBA with
+// operating system authentication instead of the SYS password. Only the
+// operations on a database of this host accept it, and the wallet takes
+// precedence.
+func (c *DBConfig) UsesOSAuthentication() bool {
+	switch c.Operation {
+	case OperationDeletePDB, OperationUnplugPDB, OperationPlugPDB, OperationRelocatePDB, OperationConfigure:
+		return c.UseOSAuthentication && !c.UsesWallet()
+	case OperationTemplate:
+		// A structure-only template is read over a database connection
+		return c.UseOSAuthentication && c.TemplateIncludeDatafiles
+	default:
+		return false
+	}
+}
+
+// Options returns the configurable options as currently set in the config
+func (c *DBConfig) Options() DatabaseOptions {
+	return DatabaseOptions{
+		EnableArchiveLog:    c.EnableArchiveLog,
+		EMConfiguration:     c.EMConfiguration,
+		EMPort:              c.EMPort,
+		CloudControlAgent:   c.CloudControlAgent,
+		EnableDataVault:     c.EnableDataVault,
+		EnableLabelSecurity: c.EnableLabelSecurity,
+	}
+}
+
+// Baseline returns the current state of the database being configured
+func (c *DBConfig) Baseline() DatabaseOptions {
+	if c.ConfigureBaseline != nil {
+		return *c.ConfigureBaseline
+	}
+	return NewDBConfig().Options()
+}
+
+// SetOptions sets the configurable options in the config
+func (c *DBConfig) SetOptions(o DatabaseOptions) {
+	c.EnableArchiveLog = o.EnableArchiveLog
+	c.EMConfiguration = o.EMConfiguration
+	c.EMPort = o.EMPort
+	c.CloudControlAgent = o.CloudControlAgent
+	c.EnableDataVault = o.EnableDataVault
+	c.EnableLabelSecurity = o.EnableLabelSecurity
+}
+
+// EMChanged returns true if the Enterprise Manager settings differ from the baseline
+func (o DatabaseOptions) EMChanged(baseline DatabaseOptions) bool {
+	if o.EMConfiguration != baseline.EMConfiguration {
+		return true
+	}
+	switch o.EMConfiguration {
+	case EMConfigDBExpress:
+		return o.EMPort != baseline.EMPort
+	case EMConfigCentral:
+		return o.EMPort != baseline.EMPort || o.CloudControlAgent != baseline.CloudControlAgent
+	default:
+		return false
 	}
 }
@@ -0,0 +1,47 @@
+package model
+
+// InitParamInfo describes a well-known initialization parameter
+type InitParamInfo struct {
+	Name        string
+	Description string
+	Numeric     bool // Value must be a positive integer
+}
+
+// KnownInitParams lists commonly tuned initialization parameters,
+// used for autocompletion and basic value checks
+var KnownInitParams = []InitParamInfo{
+	{Name: "audit_file_dest", Description: "Directory for audit trail files"},
+	{Name: "audit_trail", Description: "Traditional auditing destination (NONE, OS, DB, DB,EXTENDED, XML)"},
+	{Name: "compatible", Description: "Release the database must remain compatible with"},
+	{Name: "control_files", Description: "Control file locations"},
+	{Name: "cursor_sharing", Description: "Literal replacement for shared cursors (EXACT, FORCE)"},
+	{Name: "db_block_size", Description: "Standard block size in bytes (2048-32768, power of two)", Numeric: true},
+	{Name: "db_create_file_dest", Description: "Default location for Oracle Managed Files"},
+	{Name: "db_files", Description: "Maximum number of data files", Numeric: true},
+	{Name: "db_recovery_file_dest_size", Description: "Fast Recovery Area size limit"},
+	{Name: "diagnostic_dest", Description: "Automatic Diagnostic Repository base"},
+	{Name: "enable_pluggable_database", Description: "Allow creation as a CDB (TRUE, FALSE)"},
+	{Name: "job_queue_processes", Description: "Maximum number of job worker processes", Numeric: true},
+	{Name: "log_archive_format", Description: "Archived redo log file name format"},
+	{Name: "nls_date_format", Description: "Default date format"},
+	{Name: "nls_language", Description: "Default language of the database"},
+	{Name: "nls_territory", Description: "Default territory of the database"},
+	{Name: "open_cursors", Description: "Maximum open cursors per session", Numeric: true},
+	{Name: "optimizer_mode", Description: "Optimizer goal (ALL_ROWS, FIRST_ROWS_n)"},
+	{Name: "parallel_max_servers", Description: "Maximum parallel execution processes", Numeric: true},
+	{Name: "processes", Description: "Maximum number of operating system processes", Numeric: true},
+	{Name: "remote_login_passwordfile", Description: "Password file usage (NONE, EXCLUSIVE, SHARED)"},
+	{Name: "sessions", Description: "Maximum number of sessions", Numeric: true},
+	{Name: "undo_retention", Description: "Undo retention target in seconds", Numeric: true},
+	{Name: "undo_tablespace", Description: "Undo tablespace used by the instance"},
+}
+
+// LookupInitParam returns the catalog entry for a parameter name, if known
+func LookupInitParam(name string) (InitParamInfo, bool) {
+	for _, p := range KnownInitParams {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return InitParamInfo{}, false
+}
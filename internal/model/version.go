@@ -0,0 +1,76 @@
+package model
+
+// OracleVersion represents the Oracle Database release the command targets
+type OracleVersion string
+
+const (
+	Version122  OracleVersion = "12.2"
+	Version19c  OracleVersion = "19c"
+	Version21c  OracleVersion = "21c"
+	Version23ai OracleVersion = "23ai"
+)
+
+// SupportedVersions lists the releases in ascending order
+var SupportedVersions = []OracleVersion{Version122, Version19c, Version21c, Version23ai}
+
+// IsSupported returns true if the version is one of SupportedVersions
+func (v OracleVersion) IsSupported() bool {
+	for _, supported := range SupportedVersions {
+		if v == supported {
+			return true
+		}
+	}
+	return false
+}
+
+// atLeast returns true if v is the same release as other or a later one
+func (v OracleVersion) atLeast(other OracleVersion) bool {
+	vi, oi := -1, -1
+	for i, supported := range SupportedVersions {
+		if supported == v {
+			vi = i
+		}
+		if supported == other {
+			oi = i
+		}
+	}
+	return vi >= 0 && vi >= oi
+}
+
+// SupportsNonCDB returns false from 21c on, where only container databases can be created
+func (v OracleVersion) SupportsNonCDB() bool {
+	return !v.atLeast(Version21c)
+}
+
+// SupportsEMExpress returns false for 23ai, where EM Express was desupported
+func (v OracleVersion) SupportsEMExpress() bool {
+	return !v.atLeast(Version23ai)
+}
+
+// ResponseFileSchema returns the responseFileVersion value DBCA expects
+func (v OracleVersion) ResponseFileSchema() string {
+	switch v {
+	case Version122:
+		return "/oracle/assistants/rspfmt_dbca_response_schema_v12.2.0"
+	case Version21c:
+		return "/oracle/assistants/rspfmt_dbca_response_schema_v21.0.0"
+	case Version23ai:
+		return "/oracle/assistants/rspfmt_dbca_response_schema_v23.0.0"
+	default:
+		return "/oracle/assistants/rspfmt_dbca_response_schema_v19.0.0"
+	}
+}
+
+// TemplateFile returns the template file name shipped with the release.
+// 23ai ships the seed templates as .dbc files.
+func (v OracleVersion) TemplateFile(template DatabaseTemplate) string {
+	if v.atLeast(Version23ai) {
+		switch template {
+		case TemplateGeneralPurpose:
+			return "General_Purpose.dbc"
+		case TemplateDataWarehouse:
+			return "Data_Warehouse.dbc"
+		}
+	}
+	return string(template)
+}
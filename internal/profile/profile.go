@@ -0,0 +1,121 @@
+package profile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// CurrentVersion is the profile format version written by Save
+const CurrentVersion = 1
+
+// Format represents the profile serialization format
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+// Profile is the on-disk representation of a saved wizard session
+type Profile struct {
+	Version int             `json:"version"`
+	Config  *model.DBConfig `json:"config"`
+}
+
+// FormatForPath picks the serialization format from a file extension
+func FormatForPath(path string) Format {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return FormatYAML
+	default:
+		return FormatJSON
+	}
+}
+
+// Save writes the config as a profile, choosing the format from the file extension
+func Save(path string, config *model.DBConfig, includeSecrets bool) error {
+	data, err := Marshal(config, FormatForPath(path), includeSecrets)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// Load reads a profile, choosing the format from the file extension
+func Load(path string) (*model.DBConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Unmarshal(data, FormatForPath(path))
+}
+
+// Marshal serializes the config as a versioned profile.
+// Passwords are left out unless includeSecrets is set.
+func Marshal(config *model.DBConfig, format Format, includeSecrets bool) ([]byte, error) {
+	cfg := *config
+	if !includeSecrets {
+		stripSecrets(&cfg)
+	}
+
+	data, err := json.MarshalIndent(Profile{Version: CurrentVersion, Config: &cfg}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if format == FormatYAML {
+		return jsonToYAML(data)
+	}
+	return append(data, '\n'), nil
+}
+
+// Unmarshal parses a profile. Fields missing from the profile keep the
+// defaults from model.NewDBConfig.
+func Unmarshal(data []byte, format Format) (*model.DBConfig, error) {
+	if format == FormatYAML {
+		var err error
+		data, err = yamlToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	p := Profile{Config: model.NewDBConfig()}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&p); err != nil {
+		return nil, fmt.Errorf("invalid profile: %w", err)
+	}
+
+	if p.Version < 1 || p.Version > CurrentVersion {
+		return nil, fmt.Errorf("unsupported profile version %d (expected 1-%d)", p.Version, CurrentVersion)
+	}
+
+	// A profile may omit the maps entirely
+	if p.Config.InitParams == nil {
+		p.Config.InitParams = make(map[string]string)
+	}
+	if p.Config.ExtraResponseParams == nil {
+		p.Config.ExtraResponseParams = make(map[string]string)
+	}
+
+	return p.Config, nil
+}
+
+// stripSecrets clears every password held in the config
+func stripSecrets(config *model.DBConfig) {
+	config.CommonPassword = ""
+	config.SysPassword = ""
+	config.SystemPassword = ""
+	config.PDBAdminPassword = ""
+	config.DataVaultOwnerPassword = ""
+	config.DataVaultAccountManagerPassword = ""
+	config.RemoteSysDBAPassword = ""
+	config.DBLinkPassword = ""
+}
@@ -0,0 +1,162 @@
+package profile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"dbca_tui/internal/model"
+)
+
+// testConfig returns a config whose strings exercise the YAML quoting rules
+func testConfig() *model.DBConfig {
+	c := model.NewDBConfig()
+	c.GlobalDBName = "sales.example.com"
+	c.SID = "sales"
+	c.SysPassword = "Sys#Pwd: 1"
+	c.SystemPassword = "  leading spaces"
+	c.NodeList = "node1,node2"
+	c.InitParams["nls_date_format"] = "YYYY-MM-DD HH24:MI:SS"
+	c.InitParams["audit_trail"] = "'DB,EXTENDED'"
+	c.InitParams["log_archive_dest_1"] = "LOCATION=/arch # primary"
+	c.ExtraResponseParams["variables"] = "ORACLE_BASE_HOME=/u01/app/oracle/homes/OraDB19Home1"
+	c.ExtraResponseParams["key: with colon"] = "true"
+	return c
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *model.DBConfig
+	}{
+		{"defaults", model.NewDBConfig()},
+		{"quoting", testConfig()},
+		{"empty maps", func() *model.DBConfig {
+			c := model.NewDBConfig()
+			c.InitParams = map[string]string{}
+			c.ExtraResponseParams = map[string]string{}
+			return c
+		}()},
+	}
+
+	for _, tt := range tests {
+		for _, format := range []Format{FormatJSON, FormatYAML} {
+			data, err := Marshal(tt.config, format, true)
+			if err != nil {
+				t.Errorf("%s: Marshal(%s) error = %v", tt.name, format, err)
+				continue
+			}
+			got, err := Unmarshal(data, format)
+			if err != nil {
+				t.Errorf("%s: Unmarshal(%s) error = %v\n%s", tt.name, format, err, data)
+				continue
+			}
+			if !reflect.DeepEqual(got, tt.config) {
+				t.Errorf("%s: %s round trip = %+v, want %+v", tt.name, format, got, tt.config)
+			}
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		data   string
+		check  func(c *model.DBConfig) bool
+	}{
+		{
+			name:   "missing fields keep their defaults",
+			format: FormatJSON,
+			data:   `{"version": 1, "config": {"sid": "sales"}}`,
+			check: func(c *model.DBConfig) bool {
+				return c.SID == "sales" && c.GlobalDBName == "orcl" && c.ListenerPort == 1521
+			},
+		},
+		{
+			name:   "null maps are replaced",
+			format: FormatJSON,
+			data:   `{"version": 1, "config": {"initParams": null, "extraResponseParams": null}}`,
+			check: func(c *model.DBConfig) bool {
+				return c.InitParams != nil && c.ExtraResponseParams != nil
+			},
+		},
+		{
+			name:   "hand-written yaml",
+			format: FormatYAML,
+			data: "---\n# comment\nversion: 1\nconfig:\n  sid: sales # trailing comment\n" +
+				"  globalDBName: 'it''s.example.com'\n  listenerPort: 1522\n  createAsContainerDB: false\n" +
+				"  initParams:\n    \"a: b\": \"#x\"\n  extraResponseParams: {}\n",
+			check: func(c *model.DBConfig) bool {
+				return c.SID == "sales" && c.GlobalDBName == "it's.example.com" && c.ListenerPort == 1522 &&
+					!c.CreateAsContainerDB && c.InitParams["a: b"] == "#x" && len(c.ExtraResponseParams) == 0
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := Unmarshal([]byte(tt.data), tt.format)
+		if err != nil {
+			t.Errorf("%s: Unmarshal() error = %v", tt.name, err)
+			continue
+		}
+		if !tt.check(got) {
+			t.Errorf("%s: Unmarshal() = %+v", tt.name, got)
+		}
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		data   string
+		want   string
+	}{
+		{"unknown field", FormatJSON, `{"version": 1, "config": {"sidd": "sales"}}`, `unknown field "sidd"`},
+		{"unknown top-level field", FormatYAML, "version: 1\nsecrets: {}\n", `unknown field "secrets"`},
+		{"missing version", FormatJSON, `{"config": {}}`, "unsupported profile version 0"},
+		{"future version", FormatYAML, "version: 2\nconfig: {}\n", "unsupported profile version 2"},
+		{"duplicate key", FormatYAML, "version: 1\nversion: 1\n", `duplicate key "version"`},
+		{"tab indentation", FormatYAML, "version: 1\nconfig:\n\tsid: x\n", "tabs are not allowed"},
+		{"text after a quoted string", FormatYAML, "version: 1\nconfig:\n  sid: \"a\" b\n", "unexpected text after quoted string"},
+		{"empty", FormatYAML, "# nothing\n", "empty profile"},
+	}
+
+	for _, tt := range tests {
+		_, err := Unmarshal([]byte(tt.data), tt.format)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: Unmarshal() error = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestMarshalWithoutSecrets(t *testing.T) {
+	config := testConfig()
+
+	// Every password field, including ones added later, must be stripped
+	passwords := 0
+	v := reflect.ValueOf(config).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if strings.HasSuffix(v.Type().Field(i).Name, "Password") && v.Field(i).Kind() == reflect.String {
+			v.Field(i).SetString("Secret_Pwd1")
+			passwords++
+		}
+	}
+	if passwords != 8 {
+		t.Errorf("DBConfig has %d password fields, want 8", passwords)
+	}
+
+	for _, format := range []Format{FormatJSON, FormatYAML} {
+		data, err := Marshal(config, format, false)
+		if err != nil {
+			t.Fatalf("Marshal(%s) error = %v", format, err)
+		}
+		if strings.Contains(string(data), "Secret_Pwd1") {
+			t.Errorf("Marshal(%s) kept a password:\n%s", format, data)
+		}
+	}
+	if config.SysPassword != "Secret_Pwd1" {
+		t.Errorf("Marshal() cleared the passwords of the caller's config")
+	}
+}
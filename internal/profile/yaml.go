@@ -0,0 +1,361 @@
+package profile
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The profile YAML support covers the subset needed to represent a
+// DBConfig: nested block mappings, block sequences of scalars, flow-style
+// empty collections ({} and []) and plain, single- or double-quoted
+// scalars. Conversion goes through JSON so both formats share the same
+// field names and decoding rules.
+
+// jsonToYAML converts a JSON object into block-style YAML, preserving key order
+func jsonToYAML(data []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, fmt.Errorf("profile must be a JSON object")
+	}
+
+	var b bytes.Buffer
+	b.WriteString("# DBCA TUI profile\n")
+	if err := writeYAMLMapping(dec, &b, 0); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// writeYAMLMapping writes the members of an object whose '{' was already consumed
+func writeYAMLMapping(dec *json.Decoder, b *bytes.Buffer, indent int) error {
+	pad := strings.Repeat("  ", indent)
+
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		prefix := pad + yamlKey(keyTok.(string)) + ":"
+
+		valTok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch v := valTok.(type) {
+		case json.Delim:
+			empty := !dec.More()
+			switch {
+			case v == '{' && empty:
+				b.WriteString(prefix + " {}\n")
+				_, err = dec.Token()
+			case v == '{':
+				b.WriteString(prefix + "\n")
+				err = writeYAMLMapping(dec, b, indent+1)
+			case v == '[' && empty:
+				b.WriteString(prefix + " []\n")
+				_, err = dec.Token()
+			default:
+				b.WriteString(prefix + "\n")
+				err = writeYAMLSequence(dec, b, indent+1)
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			b.WriteString(prefix + " " + yamlScalar(v) + "\n")
+		}
+	}
+
+	// Consume the closing '}'
+	_, err := dec.Token()
+	return err
+}
+
+// writeYAMLSequence writes the scalar items of an array whose '[' was already consumed
+func writeYAMLSequence(dec *json.Decoder, b *bytes.Buffer, indent int) error {
+	pad := strings.Repeat("  ", indent)
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if _, ok := tok.(json.Delim); ok {
+			return fmt.Errorf("nested collections inside sequences are not supported")
+		}
+		b.WriteString(pad + "- " + yamlScalar(tok) + "\n")
+	}
+
+	// Consume the closing ']'
+	_, err := dec.Token()
+	return err
+}
+
+var plainKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_$#.\-]+$`)
+
+// yamlKey quotes a mapping key when it is not a plain identifier
+func yamlKey(key string) string {
+	if plainKeyPattern.MatchString(key) {
+		return key
+	}
+	return quoteString(key)
+}
+
+// yamlScalar renders a JSON token as a YAML scalar. Strings are always
+// double-quoted so values such as "19c", "true" or "1521" keep their type.
+func yamlScalar(tok json.Token) string {
+	switch v := tok.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		return quoteString(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// quoteString renders a double-quoted scalar using JSON escapes, which YAML accepts
+func quoteString(s string) string {
+	var b bytes.Buffer
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// yamlLine is a significant (non-blank, non-comment) line of a YAML document
+type yamlLine struct {
+	num    int
+	indent int
+	text   string
+}
+
+// yamlToJSON converts the supported YAML subset into JSON
+func yamlToJSON(data []byte) ([]byte, error) {
+	var lines []yamlLine
+	for i, raw := range strings.Split(string(data), "\n") {
+		raw = strings.TrimRight(raw, " \r")
+		trimmed := strings.TrimLeft(raw, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || trimmed == "---" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "\t") {
+			return nil, fmt.Errorf("yaml line %d: tabs are not allowed for indentation", i+1)
+		}
+		lines = append(lines, yamlLine{num: i + 1, indent: len(raw) - len(trimmed), text: trimmed})
+	}
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("empty profile")
+	}
+
+	value, next, err := parseYAMLBlock(lines, 0, lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if next < len(lines) {
+		return nil, fmt.Errorf("yaml line %d: unexpected indentation", lines[next].num)
+	}
+
+	return json.Marshal(value)
+}
+
+// parseYAMLBlock parses the mapping or sequence starting at lines[i]
+func parseYAMLBlock(lines []yamlLine, i, indent int) (interface{}, int, error) {
+	if isSequenceItem(lines[i].text) {
+		return parseYAMLSequence(lines, i, indent)
+	}
+	return parseYAMLMapping(lines, i, indent)
+}
+
+func isSequenceItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// parseYAMLMapping parses key: value lines at the given indentation
+func parseYAMLMapping(lines []yamlLine, i, indent int) (interface{}, int, error) {
+	result := make(map[string]interface{})
+
+	for i < len(lines) && lines[i].indent == indent {
+		line := lines[i]
+		if isSequenceItem(line.text) {
+			return nil, i, fmt.Errorf("yaml line %d: unexpected sequence item in mapping", line.num)
+		}
+
+		key, rest, err := splitYAMLKey(line.text)
+		if err != nil {
+			return nil, i, fmt.Errorf("yaml line %d: %w", line.num, err)
+		}
+		if _, dup := result[key]; dup {
+			return nil, i, fmt.Errorf("yaml line %d: duplicate key %q", line.num, key)
+		}
+		i++
+
+		if rest != "" {
+			value, err := parseYAMLScalar(rest)
+			if err != nil {
+				return nil, i, fmt.Errorf("yaml line %d: %w", line.num, err)
+			}
+			result[key] = value
+			continue
+		}
+
+		// A nested block follows if the next line is indented further
+		if i < len(lines) && lines[i].indent > indent {
+			value, next, err := parseYAMLBlock(lines, i, lines[i].indent)
+			if err != nil {
+				return nil, next, err
+			}
+			result[key] = value
+			i = next
+		} else {
+			result[key] = nil
+		}
+	}
+
+	if i < len(lines) && lines[i].indent > indent {
+		return nil, i, fmt.Errorf("yaml line %d: unexpected indentation", lines[i].num)
+	}
+
+	return result, i, nil
+}
+
+// parseYAMLSequence parses "- scalar" lines at the given indentation
+func parseYAMLSequence(lines []yamlLine, i, indent int) (interface{}, int, error) {
+	result := []interface{}{}
+
+	for i < len(lines) && lines[i].indent == indent && isSequenceItem(lines[i].text) {
+		item := strings.TrimSpace(strings.TrimPrefix(lines[i].text, "-"))
+		quoted := strings.HasPrefix(item, "\"") || strings.HasPrefix(item, "'")
+		if !quoted && (strings.Contains(item, ": ") || strings.HasSuffix(item, ":")) {
+			return nil, i, fmt.Errorf("yaml line %d: mappings inside sequences are not supported", lines[i].num)
+		}
+
+		value, err := parseYAMLScalar(item)
+		if err != nil {
+			return nil, i, fmt.Errorf("yaml line %d: %w", lines[i].num, err)
+		}
+		result = append(result, value)
+		i++
+	}
+
+	return result, i, nil
+}
+
+// splitYAMLKey splits "key: value" into its key and the raw value text
+func splitYAMLKey(text string) (string, string, error) {
+	if strings.HasPrefix(text, "\"") || strings.HasPrefix(text, "'") {
+		end := closingQuote(text)
+		if end < 0 {
+			return "", "", fmt.Errorf("unterminated quoted key")
+		}
+		key, err := parseYAMLScalar(text[:end+1])
+		if err != nil {
+			return "", "", err
+		}
+		rest := strings.TrimSpace(text[end+1:])
+		if !strings.HasPrefix(rest, ":") {
+			return "", "", fmt.Errorf("expected ':' after key")
+		}
+		return key.(string), strings.TrimSpace(rest[1:]), nil
+	}
+
+	idx := strings.Index(text, ": ")
+	if idx < 0 {
+		if !strings.HasSuffix(text, ":") {
+			return "", "", fmt.Errorf("expected 'key: value'")
+		}
+		idx = len(text) - 1
+	}
+	return strings.TrimSpace(text[:idx]), strings.TrimSpace(text[idx+1:]), nil
+}
+
+var numberPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][-+]?[0-9]+)?$`)
+
+// parseYAMLScalar converts a scalar (or empty flow collection) into a JSON-compatible value
+func parseYAMLScalar(text string) (interface{}, error) {
+	switch {
+	case strings.HasPrefix(text, "\""):
+		end := closingQuote(text)
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated double-quoted string")
+		}
+		if err := checkTrailing(text[end+1:]); err != nil {
+			return nil, err
+		}
+		return strconv.Unquote(text[:end+1])
+
+	case strings.HasPrefix(text, "'"):
+		end := closingQuote(text)
+		if end < 0 {
+			return nil, fmt.Errorf("unterminated single-quoted string")
+		}
+		if err := checkTrailing(text[end+1:]); err != nil {
+			return nil, err
+		}
+		return strings.ReplaceAll(text[1:end], "''", "'"), nil
+	}
+
+	// Strip a trailing comment from plain scalars
+	if idx := strings.Index(text, " #"); idx >= 0 {
+		text = strings.TrimSpace(text[:idx])
+	}
+
+	switch text {
+	case "{}":
+		return map[string]interface{}{}, nil
+	case "[]":
+		return []interface{}{}, nil
+	case "", "~", "null", "Null", "NULL":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+
+	if numberPattern.MatchString(text) {
+		return json.Number(text), nil
+	}
+	return text, nil
+}
+
+// closingQuote returns the index of the quote closing the string at text[0]
+func closingQuote(text string) int {
+	quote := text[0]
+	for i := 1; i < len(text); i++ {
+		switch {
+		case quote == '"' && text[i] == '\\':
+			i++
+		case quote == '\'' && text[i] == '\'' && i+1 < len(text) && text[i+1] == '\'':
+			i++
+		case text[i] == quote:
+			return i
+		}
+	}
+	return -1
+}
+
+// checkTrailing allows only whitespace or a comment after a quoted scalar
+func checkTrailing(rest string) error {
+	rest = strings.TrimSpace(rest)
+	if rest == "" || strings.HasPrefix(rest, "#") {
+		return nil
+	}
+	return fmt.Errorf("unexpected text after quoted string: %q", rest)
+}
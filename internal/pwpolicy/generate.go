@@ -0,0 +1,121 @@
+package pwpolicy
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+)
+
+// GeneratedLength is the length of generated passwords, unless the policy
+// asks for more
+const GeneratedLength = 16
+
+// Character classes of generated passwords. The special characters are
+// the ones Oracle allows in unquoted identifiers, so the password needs no
+// quoting in SQL*Plus, response files or shell variables.
+const (
+	upperChars   = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	lowerChars   = "abcdefghijklmnopqrstuvwxyz"
+	digitChars   = "0123456789"
+	specialChars = "#_"
+)
+
+// maxAttempts bounds the retries when a random password happens to contain
+// a user name, a database name or a dictionary word
+const maxAttempts = 100
+
+// Generate returns a cryptographically random password that starts with a
+// letter and complies with the policy for every account
+func (p Policy) Generate(accounts ...Account) (string, error) {
+	if len(accounts) == 0 {
+		accounts = []Account{{}}
+	}
+	length := min(max(p.MinLength, GeneratedLength), MaxBytes)
+
+	for range maxAttempts {
+		password, err := p.random(length)
+		if err != nil {
+			return "", err
+		}
+		if p.compliant(password, accounts) {
+			return password, nil
+		}
+	}
+	return "", errors.New("no password complying with the policy could be generated")
+}
+
+// random draws the characters each class requires, fills up the length
+// from all classes and shuffles them, moving a letter to the front
+func (p Policy) random(length int) (string, error) {
+	var chars []byte
+	draw := func(n int, set string) error {
+		for range n {
+			i, err := randomIndex(len(set))
+			if err != nil {
+				return err
+			}
+			chars = append(chars, set[i])
+		}
+		return nil
+	}
+
+	required := []struct {
+		n   int
+		set string
+	}{
+		{max(p.MinUpper, 1), upperChars},
+		{max(p.MinLower, 1), lowerChars},
+		{max(p.MinDigits, 1), digitChars},
+		{max(p.MinSpecial, 1), specialChars},
+	}
+	for _, r := range required {
+		if err := draw(r.n, r.set); err != nil {
+			return "", err
+		}
+	}
+	if err := draw(length-len(chars), upperChars+lowerChars+digitChars+specialChars); err != nil {
+		return "", err
+	}
+
+	// Fisher-Yates shuffle
+	for i := len(chars) - 1; i > 0; i-- {
+		j, err := randomIndex(i + 1)
+		if err != nil {
+			return "", err
+		}
+		chars[i], chars[j] = chars[j], chars[i]
+	}
+
+	// Unquoted Oracle passwords must start with a letter
+	for i, c := range chars {
+		if isLetter(c) {
+			chars[0], chars[i] = chars[i], chars[0]
+			break
+		}
+	}
+
+	return string(chars), nil
+}
+
+// compliant returns true if the password passes the policy for every account
+func (p Policy) compliant(password string, accounts []Account) bool {
+	for _, account := range accounts {
+		if p.Check(password, account) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// randomIndex returns a uniformly distributed index below n
+func randomIndex(n int) (int, error) {
+	i, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(i.Int64()), nil
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,281 @@
+// Package pwpolicy checks database account passwords against complexity
+// rules modelled on the verify functions Oracle ships in utlpwdmg.sql.
+package pwpolicy
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// MaxBytes is the longest password DBCA accepts
+const MaxBytes = 30
+
+// forbidden lists the characters DBCA rejects in a password
+const forbidden = `"@`
+
+// Policy is a set of password complexity rules
+type Policy struct {
+	Name        string // Name in profiles, e.g. ora12c
+	Function    string // Oracle verify function the rules mirror
+	Description string
+	MinLength   int
+	MinLetters  int
+	MinUpper    int
+	MinLower    int
+	MinDigits   int
+	MinSpecial  int
+	MinDistance int // Characters a new password must differ from the old one by
+}
+
+var (
+	// Ora12c mirrors ora12c_verify_function
+	Ora12c = Policy{
+		Name:        "ora12c",
+		Function:    "ora12c_verify_function",
+		Description: "8 characters with a letter and a digit",
+		MinLength:   8,
+		MinLetters:  1,
+		MinDigits:   1,
+		MinDistance: 3,
+	}
+
+	// Ora12cStrong mirrors ora12c_strong_verify_function
+	Ora12cStrong = Policy{
+		Name:        "ora12c_strong",
+		Function:    "ora12c_strong_verify_function",
+		Description: "9 characters with 2 upper case, 2 lower case, 2 digits and 2 special characters",
+		MinLength:   9,
+		MinUpper:    2,
+		MinLower:    2,
+		MinDigits:   2,
+		MinSpecial:  2,
+		MinDistance: 3,
+	}
+)
+
+// Policies lists the selectable policies; the first one is the default
+var Policies = []Policy{Ora12c, Ora12cStrong}
+
+// Lookup returns the policy with the given name. An empty name selects
+// the default policy.
+func Lookup(name string) (Policy, bool) {
+	if name == "" {
+		return Policies[0], true
+	}
+	for _, p := range Policies {
+		if strings.EqualFold(p.Name, name) {
+			return p, true
+		}
+	}
+	return Policy{}, false
+}
+
+// Account is the account a password is set for. The password must not
+// contain its user name or the names of its database, and must differ
+// enough from the password it replaces.
+type Account struct {
+	Username    string
+	DBNames     []string // SID, DB_NAME, PDB name
+	OldPassword string   // Empty for a new account
+}
+
+// simpleWords are dictionary and default passwords the verify functions
+// reject with any digits or punctuation around them
+var simpleWords = []string{
+	"welcome", "database", "account", "user", "password", "oracle",
+	"computer", "abcdefg", "abcdef", "qwerty", "letmein", "manager",
+	"tiger", "changeoninstall", "admin", "secret", "default",
+}
+
+// Check returns the first rule the password breaks, or an empty string
+// if the password complies with the policy
+func (p Policy) Check(password string, account Account) string {
+	if msg := CheckFormat(password); msg != "" {
+		return msg
+	}
+
+	c := countClasses(password)
+	switch {
+	case len(password) < p.MinLength:
+		return fmt.Sprintf("Password must be at least %d characters", p.MinLength)
+	case c.letters < p.MinLetters:
+		return fmt.Sprintf("Password must contain at least %s", plural(p.MinLetters, "letter"))
+	case c.upper < p.MinUpper:
+		return fmt.Sprintf("Password must contain at least %s", plural(p.MinUpper, "upper case letter"))
+	case c.lower < p.MinLower:
+		return fmt.Sprintf("Password must contain at least %s", plural(p.MinLower, "lower case letter"))
+	case c.digits < p.MinDigits:
+		return fmt.Sprintf("Password must contain at least %s", plural(p.MinDigits, "digit"))
+	case c.special < p.MinSpecial:
+		return fmt.Sprintf("Password must contain at least %s", plural(p.MinSpecial, "special character"))
+	}
+
+	lower := strings.ToLower(password)
+	if user := strings.ToLower(account.Username); user != "" {
+		if strings.Contains(lower, user) {
+			return fmt.Sprintf("Password must not contain the user name %s", account.Username)
+		}
+		if strings.Contains(lower, reverse(user)) {
+			return fmt.Sprintf("Password must not contain the user name %s reversed", account.Username)
+		}
+	}
+	for _, name := range account.DBNames {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" && strings.Contains(lower, name) {
+			return "Password must not contain the database name"
+		}
+	}
+	if word := simpleWord(password); word != "" {
+		return fmt.Sprintf("Password must not be based on the dictionary word %q", word)
+	}
+	if account.OldPassword != "" && distance(password, account.OldPassword) < p.MinDistance {
+		return fmt.Sprintf("Password must differ from the old password by at least %s", plural(p.MinDistance, "character"))
+	}
+
+	return ""
+}
+
+// CheckFormat returns why DBCA would reject the password, or an empty
+// string. These rules apply to any password, including those of existing
+// accounts that no policy was enforced on.
+func CheckFormat(password string) string {
+	if len(password) > MaxBytes {
+		return fmt.Sprintf("Password must not be longer than %d bytes", MaxBytes)
+	}
+	if strings.ContainsAny(password, forbidden) {
+		return `Password must not contain " or @`
+	}
+	return ""
+}
+
+// Strength rates a password from 0 (very weak) to 4 (strong). Length and
+// the mix of character classes raise the score; a dictionary word or a
+// password DBCA rejects keeps it low.
+func Strength(password string) int {
+	if password == "" || CheckFormat(password) != "" {
+		return 0
+	}
+
+	c := countClasses(password)
+	classes := 0
+	for _, n := range []int{c.upper, c.lower, c.digits, c.special} {
+		if n > 0 {
+			classes++
+		}
+	}
+
+	score := 0
+	if len(password) >= 8 {
+		score++
+	}
+	if len(password) >= 12 {
+		score++
+	}
+	if classes >= 3 {
+		score++
+	}
+	if classes == 4 {
+		score++
+	}
+	if simpleWord(password) != "" {
+		score = min(score, 1)
+	}
+	return score
+}
+
+// StrengthLabel names a Strength score
+func StrengthLabel(score int) string {
+	switch score {
+	case 0:
+		return "Very weak"
+	case 1:
+		return "Weak"
+	case 2:
+		return "Fair"
+	case 3:
+		return "Good"
+	default:
+		return "Strong"
+	}
+}
+
+// classes counts the characters of each class in a password
+type classes struct {
+	letters, upper, lower, digits, special int
+}
+
+func countClasses(password string) classes {
+	var c classes
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			c.letters++
+			c.upper++
+		case unicode.IsLetter(r):
+			c.letters++
+			c.lower++
+		case unicode.IsDigit(r):
+			c.digits++
+		default:
+			c.special++
+		}
+	}
+	return c
+}
+
+// simpleWord returns the dictionary word a password is made of once its
+// digits and punctuation are removed, or an empty string
+func simpleWord(password string) string {
+	letters := strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, password)
+
+	for _, word := range simpleWords {
+		if letters == word {
+			return word
+		}
+	}
+	return ""
+}
+
+// distance returns the Levenshtein distance between two passwords, like
+// ora_string_distance in utlpwdmg.sql
+func distance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	prev := make([]int, len(t)+1)
+	curr := make([]int, len(t)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(s); i++ {
+		curr[0] = i
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(t)]
+}
+
+func reverse(s string) string {
+	runes := []rune(s)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return string(runes)
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
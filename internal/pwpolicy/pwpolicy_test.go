@@ -0,0 +1,131 @@
+package pwpolicy
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	sys := Account{Username: "SYS", DBNames: []string{"sales", "salespdb"}}
+
+	tests := []struct {
+		name     string
+		policy   Policy
+		password string
+		account  Account
+		want     string
+	}{
+		{"compliant", Ora12c, "Kx7_pq2m", sys, ""},
+		{"compliant strong", Ora12cStrong, "Kx7_Pq2#m", sys, ""},
+		{"too long", Ora12c, "Kx7_pq2mKx7_pq2mKx7_pq2mKx7_pq2", Account{}, "Password must not be longer than 30 bytes"},
+		{"double quote", Ora12c, `Kx7_pq2"m`, Account{}, `Password must not contain " or @`},
+		{"at sign", Ora12c, "Kx7_pq2@m", Account{}, `Password must not contain " or @`},
+		{"length", Ora12c, "Kx7_pq2", Account{}, "Password must be at least 8 characters"},
+		{"strong length", Ora12cStrong, "Kx7_Pq2#", Account{}, "Password must be at least 9 characters"},
+		{"letters", Ora12c, "12345678", Account{}, "Password must contain at least 1 letter"},
+		{"digits", Ora12c, "Kxy_pqrm", Account{}, "Password must contain at least 1 digit"},
+		{"upper case", Ora12cStrong, "kx7_pq2#m", Account{}, "Password must contain at least 2 upper case letters"},
+		{"lower case", Ora12cStrong, "KX7_PQ2#M", Account{}, "Password must contain at least 2 lower case letters"},
+		{"strong digits", Ora12cStrong, "Kx7_Pqz#m", Account{}, "Password must contain at least 2 digits"},
+		{"special", Ora12cStrong, "Kx7xPq2#m", Account{}, "Password must contain at least 2 special characters"},
+		{"user name", Ora12c, "Kx7sysPq2", sys, "Password must not contain the user name SYS"},
+		{"user name in another case", Ora12c, "Kx7SySPq2", sys, "Password must not contain the user name SYS"},
+		{"user name reversed", Ora12c, "Kx7nimdaPq", Account{Username: "admin"}, "Password must not contain the user name admin reversed"},
+		{"database name", Ora12c, "Kx7SALES2", Account{DBNames: []string{"sales"}}, "Password must not contain the database name"},
+		{"PDB name", Ora12c, "Kx7salespdb", Account{DBNames: []string{" ", "salespdb"}}, "Password must not contain the database name"},
+		{"dictionary word", Ora12c, "Welcome#1", Account{}, `Password must not be based on the dictionary word "welcome"`},
+		{"same as old", Ora12c, "Kx7_pq2m", Account{OldPassword: "Kx7_pq2m"}, "Password must differ from the old password by at least 3 characters"},
+		{"two characters from old", Ora12c, "Kx7_pq2mab", Account{OldPassword: "Kx7_pq2m"}, "Password must differ from the old password by at least 3 characters"},
+		{"three characters from old", Ora12c, "Kx7_pq2mabc", Account{OldPassword: "Kx7_pq2m"}, ""},
+		{"substitutions from old", Ora12c, "Zy7_pq2n", Account{OldPassword: "Kx7_pq2m"}, ""},
+	}
+
+	for _, tt := range tests {
+		if got := tt.policy.Check(tt.password, tt.account); got != tt.want {
+			t.Errorf("%s: %s.Check(%q) = %q, want %q", tt.name, tt.policy.Name, tt.password, got, tt.want)
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"kitten", "sitting", 3},
+		{"Oracle_1", "Oracle_2", 1},
+		{"Oracle_1", "racle_1", 1},
+		{"ÄÖÜ", "AOU", 3},
+	}
+
+	for _, tt := range tests {
+		if got := distance(tt.a, tt.b); got != tt.want {
+			t.Errorf("distance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestStrength(t *testing.T) {
+	tests := []struct {
+		password string
+		want     int
+	}{
+		{"", 0},
+		{`Kx7_pq2"m`, 0},
+		{"abcdefgh", 1},
+		{"Oracle#123456", 1},
+		{"Kx7pq2mz", 2},
+		{"Kx7_pq2m", 3},
+		{"Kx7_pq2m#Zr9", 4},
+	}
+
+	for _, tt := range tests {
+		if got := Strength(tt.password); got != tt.want {
+			t.Errorf("Strength(%q) = %d, want %d", tt.password, got, tt.want)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	accounts := []Account{
+		{Username: "SYS", DBNames: []string{"sales"}},
+		{Username: "SYSTEM", DBNames: []string{"sales"}},
+	}
+
+	for _, p := range Policies {
+		for range 20 {
+			password, err := p.Generate(accounts...)
+			if err != nil {
+				t.Fatalf("%s.Generate() error = %v", p.Name, err)
+			}
+			if len(password) != GeneratedLength || !isLetter(password[0]) {
+				t.Errorf("%s.Generate() = %q, want %d characters starting with a letter", p.Name, password, GeneratedLength)
+			}
+			for _, account := range accounts {
+				if msg := p.Check(password, account); msg != "" {
+					t.Errorf("%s.Generate() = %q: %s", p.Name, password, msg)
+				}
+			}
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   string
+		wantOK bool
+	}{
+		{"", "ora12c", true},
+		{"ora12c_strong", "ora12c_strong", true},
+		{"ORA12C", "ora12c", true},
+		{"verify_function_11G", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := Lookup(tt.name)
+		if got.Name != tt.want || ok != tt.wantOK {
+			t.Errorf("Lookup(%q) = %s, %t, want %s, %t", tt.name, got.Name, ok, tt.want, tt.wantOK)
+		}
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"strconv"
 	"strings"
 
+	"dbca_tui/internal/hostprobe"
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -20,12 +22,22 @@ type ConfigStep struct {
 	memoryList          ui.SelectList
 	charsetList         ui.SelectList
 	connectionList      ui.SelectList
-	memoryInput         textinput.Model
+	inputs              []textinput.Model
+	focusIndex          int // Index into visibleMemoryFields
 	phase               int // 0=memory type, 1=memory size, 2=charset, 3=connection mode
 	enableSampleSchemas bool
+	host                *hostprobe.HostInfo // nil when the host could not be probed
 	err                 string
 }
 
+const (
+	cfgIdxTotal = iota
+	cfgIdxSGA
+	cfgIdxSGATarget
+	cfgIdxPGA
+	cfgIdxPGALimit
+)
+
 // NewConfigStep creates a new config step
 func NewConfigStep() *ConfigStep {
 	memoryItems := []ui.SelectItem{
@@ -88,9 +100,13 @@ func NewConfigStep() *ConfigStep {
 		connectionList: ui.NewSelectList(connectionItems),
 	}
 
-	s.memoryInput = textinput.New()
-	s.memoryInput.Placeholder = "2048"
-	s.memoryInput.CharLimit = 10
+	s.inputs = make([]textinput.Model, 5)
+	placeholders := []string{"2048", "1536", "0", "512", "1024"}
+	for i := range s.inputs {
+		s.inputs[i] = textinput.New()
+		s.inputs[i].Placeholder = placeholders[i]
+		s.inputs[i].CharLimit = 10
+	}
 
 	return s
 }
@@ -102,6 +118,12 @@ func (s *ConfigStep) Init(config *model.DBConfig) tea.Cmd {
 	s.err = ""
 	s.enableSampleSchemas = config.EnableSampleSchemas
 
+	// Memory recommendations are based on the local host, probed once the
+	// step is shown
+	if s.host == nil {
+		s.host, _ = hostprobe.Probe()
+	}
+
 	// Reset lists
 	s.memoryList.Reset()
 	s.charsetList.Reset()
@@ -115,6 +137,14 @@ func (s *ConfigStep) Init(config *model.DBConfig) tea.Cmd {
 		}
 	}
 
+	// A template may bring a character set the list does not offer
+	if config.CharacterSet != "" && !s.hasCharset(config.CharacterSet) {
+		s.charsetList.Items = append(s.charsetList.Items, ui.SelectItem{
+			Title:       config.CharacterSet,
+			Description: "Character set of the selected template",
+			Value:       config.CharacterSet,
+		})
+	}
 	for i, item := range s.charsetList.Items {
 		if item.Value == config.CharacterSet {
 			s.charsetList.Cursor = i
@@ -129,11 +159,31 @@ func (s *ConfigStep) Init(config *model.DBConfig) tea.Cmd {
 		}
 	}
 
-	s.memoryInput.SetValue(strconv.Itoa(config.TotalMemory))
+	// Suggest a 3:1 SGA/PGA split when no sizes were chosen yet
+	sga, pga := config.SGASize, config.PGASize
+	if sga == 0 && pga == 0 {
+		sga = config.TotalMemory * 3 / 4
+		pga = config.TotalMemory / 4
+	}
+	s.inputs[cfgIdxTotal].SetValue(strconv.Itoa(config.TotalMemory))
+	s.inputs[cfgIdxSGA].SetValue(strconv.Itoa(sga))
+	s.inputs[cfgIdxSGATarget].SetValue(strconv.Itoa(config.SGATarget))
+	s.inputs[cfgIdxPGA].SetValue(strconv.Itoa(pga))
+	s.inputs[cfgIdxPGALimit].SetValue(strconv.Itoa(config.PGAAggregateLimit))
 
 	return nil
 }
 
+// hasCharset returns true if the character set list offers the value
+func (s *ConfigStep) hasCharset(value string) bool {
+	for _, item := range s.charsetList.Items {
+		if item.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
 // Update handles messages
 func (s *ConfigStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -170,7 +220,12 @@ func (s *ConfigStep) updateMemoryType(msg tea.Msg) (wizard.Step, wizard.StepResu
 			s.memoryList.Update(msg)
 			if s.memoryList.IsSelected() {
 				s.phase = 1
-				s.memoryInput.Focus()
+				s.err = ""
+				s.focusIndex = 0
+				for i := range s.inputs {
+					s.inputs[i].Blur()
+				}
+				s.inputs[cfgIdxTotal].Focus()
 				return s, wizard.StepStay, textinput.Blink
 			}
 		default:
@@ -184,24 +239,132 @@ func (s *ConfigStep) updateMemorySize(msg tea.Msg) (wizard.Step, wizard.StepResu
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+		case "ctrl+r":
+			s.applyRecommendation()
+			return s, wizard.StepStay, nil
 		case "enter":
-			memSize, err := strconv.Atoi(strings.TrimSpace(s.memoryInput.Value()))
-			if err != nil || memSize < 256 {
-				s.err = "Memory size must be at least 256 MB"
+			if !s.validateMemory() {
 				return s, wizard.StepStay, nil
 			}
-			s.err = ""
 			s.phase = 2
-			s.memoryInput.Blur()
+			for i := range s.inputs {
+				s.inputs[i].Blur()
+			}
 			return s, wizard.StepStay, nil
 		}
 	}
 
+	idx := s.visibleMemoryFields()[s.focusIndex]
 	var cmd tea.Cmd
-	s.memoryInput, cmd = s.memoryInput.Update(msg)
+	s.inputs[idx], cmd = s.inputs[idx].Update(msg)
 	return s, wizard.StepStay, cmd
 }
 
+// visibleMemoryFields returns the inputs shown for the selected memory mode
+func (s *ConfigStep) visibleMemoryFields() []int {
+	switch s.memoryList.GetSelectedValue() {
+	case "AUTO_SGA":
+		return []int{cfgIdxTotal, cfgIdxSGA, cfgIdxPGA, cfgIdxPGALimit}
+	case "MANUAL":
+		return []int{cfgIdxTotal, cfgIdxSGA, cfgIdxSGATarget, cfgIdxPGA, cfgIdxPGALimit}
+	default:
+		return []int{cfgIdxTotal}
+	}
+}
+
+func (s *ConfigStep) moveFocus(delta int) {
+	fields := s.visibleMemoryFields()
+	s.inputs[fields[s.focusIndex]].Blur()
+	s.focusIndex = (s.focusIndex + delta + len(fields)) % len(fields)
+	s.inputs[fields[s.focusIndex]].Focus()
+}
+
+// memoryLabel returns the label of a memory input for the selected mode
+func (s *ConfigStep) memoryLabel(idx int) string {
+	switch idx {
+	case cfgIdxSGA:
+		if s.memoryList.GetSelectedValue() == "MANUAL" {
+			return "SGA Maximum Size (MB)"
+		}
+		return "SGA Target (MB)"
+	case cfgIdxSGATarget:
+		return "SGA Target (MB, 0 = size SGA components manually)"
+	case cfgIdxPGA:
+		return "PGA Aggregate Target (MB)"
+	case cfgIdxPGALimit:
+		return "PGA Aggregate Limit (MB, 0 = Oracle default)"
+	default:
+		return "Total Memory (MB)"
+	}
+}
+
+// applyRecommendation fills the memory inputs with the host-based recommendation
+func (s *ConfigStep) applyRecommendation() {
+	if s.host == nil {
+		return
+	}
+	rec := hostprobe.Recommend(s.host, s.config.DatabaseType)
+	s.inputs[cfgIdxTotal].SetValue(strconv.Itoa(rec.TotalMB))
+	s.inputs[cfgIdxSGA].SetValue(strconv.Itoa(rec.SGAMB))
+	s.inputs[cfgIdxPGA].SetValue(strconv.Itoa(rec.PGAMB))
+	s.err = ""
+}
+
+// memoryWarnings returns the warnings for the sizes currently entered
+func (s *ConfigStep) memoryWarnings() []validation.Finding {
+	pending := *s.config
+	s.Apply(&pending)
+
+	var warnings []validation.Finding
+	for _, f := range append(validation.Configuration(&pending), validation.Host(&pending, s.host)...) {
+		if f.Severity == validation.SeverityWarning {
+			warnings = append(warnings, f)
+		}
+	}
+	return warnings
+}
+
+// renderHostInfo shows the probed host resources and the recommendation
+func (s *ConfigStep) renderHostInfo(b *strings.Builder) {
+	if s.host == nil {
+		b.WriteString(ui.SubtitleStyle.Render("Host memory could not be detected") + "\n\n")
+		return
+	}
+
+	hugePages := "not configured"
+	if s.host.HugePagesConfigured() {
+		hugePages = fmt.Sprintf("%d x %d kB (%d MB)", s.host.HugePagesTotal, s.host.HugePageSizeKB, s.host.HugePagesMB())
+	}
+	b.WriteString(ui.RenderKeyValue("Host Memory", fmt.Sprintf("%d MB (%d MB available)", s.host.TotalMemoryMB, s.host.AvailableMemoryMB)) + "\n")
+	b.WriteString(ui.RenderKeyValue("CPUs", strconv.Itoa(s.host.CPUCount)) + "\n")
+	b.WriteString(ui.RenderKeyValue("HugePages", hugePages) + "\n")
+
+	rec := hostprobe.Recommend(s.host, s.config.DatabaseType)
+	b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("    Recommended for %s: %d MB total, SGA %d%% / PGA %d%% (Press ctrl+r to apply)",
+		s.config.DatabaseType, rec.TotalMB, rec.SGAPercent, rec.PGAPercent)) + "\n\n")
+}
+
+func (s *ConfigStep) validateMemory() bool {
+	s.err = ""
+
+	// Every visible size must parse before the config-level rules can run
+	for _, idx := range s.visibleMemoryFields() {
+		if n, err := strconv.Atoi(strings.TrimSpace(s.inputs[idx].Value())); err != nil || n < 0 {
+			s.err = fmt.Sprintf("%s must be a whole number", s.memoryLabel(idx))
+			return false
+		}
+	}
+
+	s.err = validateStep(s, s.config, validation.Configuration)
+	return s.err == ""
+}
+
 func (s *ConfigStep) updateCharset(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
@@ -254,9 +417,21 @@ func (s *ConfigStep) View() string {
 	case 1:
 		memType := s.memoryList.GetSelectedItem()
 		b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("Memory Management: %s", memType.Title)) + "\n\n")
-		b.WriteString(ui.LabelStyle.Render("Total Memory (MB)") + "\n")
-		b.WriteString(ui.FocusedInputStyle.Render(s.memoryInput.View()) + "\n")
-		b.WriteString(ui.SubtitleStyle.Render("    Recommended: At least 2048 MB") + "\n")
+		s.renderHostInfo(&b)
+		for i, idx := range s.visibleMemoryFields() {
+			b.WriteString(s.renderField(s.memoryLabel(idx), s.inputs[idx], s.focusIndex == i) + "\n")
+			if idx == cfgIdxTotal {
+				b.WriteString(ui.SubtitleStyle.Render("    Recommended: At least 2048 MB") + "\n")
+			}
+		}
+		if s.memoryList.GetSelectedValue() != "AUTO" {
+			b.WriteString(ui.SubtitleStyle.Render("    SGA and PGA must fit inside the total memory") + "\n")
+		}
+
+		for _, w := range s.memoryWarnings() {
+			b.WriteString("\n" + ui.WarningStyle.Render("! "+w.Message))
+		}
+		b.WriteString("\n")
 
 		if s.err != "" {
 			b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
@@ -307,11 +482,28 @@ func (s *ConfigStep) Title() string {
 func (s *ConfigStep) Apply(config *model.DBConfig) {
 	config.MemoryManagement = s.memoryList.GetSelectedValue()
 
-	memSize, _ := strconv.Atoi(strings.TrimSpace(s.memoryInput.Value()))
-	config.TotalMemory = memSize
+	size := func(idx int) int {
+		n, _ := strconv.Atoi(strings.TrimSpace(s.inputs[idx].Value()))
+		return n
+	}
+	config.TotalMemory = size(cfgIdxTotal)
+
+	// SGA/PGA sizes only apply to AUTO_SGA and MANUAL
+	config.SGASize, config.SGATarget, config.PGASize, config.PGAAggregateLimit = 0, 0, 0, 0
+	switch config.MemoryManagement {
+	case "MANUAL":
+		config.SGATarget = size(cfgIdxSGATarget)
+		fallthrough
+	case "AUTO_SGA":
+		config.SGASize = size(cfgIdxSGA)
+		config.PGASize = size(cfgIdxPGA)
+		config.PGAAggregateLimit = size(cfgIdxPGALimit)
+	}
 
 	config.CharacterSet = s.charsetList.GetSelectedValue()
-	config.NationalCharacterSet = "AL16UTF16" // Standard default
+	if config.NationalCharacterSet == "" {
+		config.NationalCharacterSet = "AL16UTF16" // Standard default, unless a template set one
+	}
 
 	if s.config.CreationMode == model.CreationModeAdvanced {
 		config.ConnectionMode = s.connectionList.GetSelectedValue()
@@ -0,0 +1,283 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ConfigureDBStep selects the existing database to configure and records its
+// current state, so that only changed options are generated
+type ConfigureDBStep struct {
+	config     *model.DBConfig
+	inputs     []textinput.Model
+	focusIndex int // Index into positions
+	useWallet  bool
+	current    model.DatabaseOptions
+	err        string
+}
+
+const (
+	cdIdxSID = iota
+	cdIdxSysPassword
+	cdIdxWalletLocation
+	cdIdxWalletAlias
+)
+
+// Focus positions of the wallet and current state toggles after the inputs
+const (
+	cdPosWallet = iota + 4
+	cdPosArchive
+	cdPosEM
+	cdPosDataVault
+	cdPosLabelSecurity
+)
+
+// emCycle is the order in which 'e' steps through the EM configurations
+var emCycle = []model.EMConfiguration{model.EMConfigNone, model.EMConfigDBExpress, model.EMConfigCentral}
+
+// NewConfigureDBStep creates a new configure database step
+func NewConfigureDBStep() *ConfigureDBStep {
+	s := &ConfigureDBStep{
+		inputs: make([]textinput.Model, 4),
+	}
+
+	s.inputs[cdIdxSID] = textinput.New()
+	s.inputs[cdIdxSID].Placeholder = "orcl"
+	s.inputs[cdIdxSID].CharLimit = 12
+
+	s.inputs[cdIdxSysPassword] = textinput.New()
+	s.inputs[cdIdxSysPassword].Placeholder = "Empty for OS authentication"
+	s.inputs[cdIdxSysPassword].EchoMode = textinput.EchoPassword
+	s.inputs[cdIdxSysPassword].EchoCharacter = '*'
+	s.inputs[cdIdxSysPassword].CharLimit = 30
+
+	s.inputs[cdIdxWalletLocation], s.inputs[cdIdxWalletAlias] = newWalletInputs()
+
+	return s
+}
+
+// Init initializes the step
+func (s *ConfigureDBStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.focusIndex = 0
+	s.err = ""
+	s.useWallet = config.UseWallet
+	s.current = config.Baseline()
+
+	s.inputs[cdIdxSID].SetValue(config.ConfigureSID)
+	s.inputs[cdIdxSysPassword].SetValue(config.SysPassword)
+	s.inputs[cdIdxWalletLocation].SetValue(config.WalletLocation)
+	s.inputs[cdIdxWalletAlias].SetValue(config.WalletAlias)
+
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+	s.inputs[cdIdxSID].Focus()
+
+	return textinput.Blink
+}
+
+// Update handles messages
+func (s *ConfigureDBStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return s, wizard.StepBack, nil
+
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+
+		case "w", "W":
+			if s.focused() == cdPosWallet {
+				s.useWallet = !s.useWallet
+			}
+
+		case "a", "A":
+			if s.focused() == cdPosArchive {
+				s.current.EnableArchiveLog = !s.current.EnableArchiveLog
+			}
+
+		case "e", "E":
+			if s.focused() == cdPosEM {
+				s.current.EMConfiguration = nextEMConfiguration(s.current.EMConfiguration)
+			}
+
+		case "d", "D":
+			if s.focused() == cdPosDataVault {
+				s.current.EnableDataVault = !s.current.EnableDataVault
+			}
+
+		case "l", "L":
+			if s.focused() == cdPosLabelSecurity {
+				s.current.EnableLabelSecurity = !s.current.EnableLabelSecurity
+			}
+		}
+	}
+
+	// Update the focused text input
+	if idx := s.focused(); idx < len(s.inputs) {
+		var cmd tea.Cmd
+		s.inputs[idx], cmd = s.inputs[idx].Update(msg)
+		return s, wizard.StepStay, cmd
+	}
+
+	return s, wizard.StepStay, nil
+}
+
+// nextEMConfiguration returns the EM configuration after em in emCycle
+func nextEMConfiguration(em model.EMConfiguration) model.EMConfiguration {
+	for i, c := range emCycle {
+		if c == em {
+			return emCycle[(i+1)%len(emCycle)]
+		}
+	}
+	return emCycle[0]
+}
+
+// positions returns the focus positions in display order; the wallet
+// replaces the SYS password
+func (s *ConfigureDBStep) positions() []int {
+	positions := []int{cdIdxSID, cdPosWallet}
+	if s.useWallet {
+		positions = append(positions, cdIdxWalletLocation, cdIdxWalletAlias)
+	} else {
+		positions = append(positions, cdIdxSysPassword)
+	}
+	return append(positions, cdPosArchive, cdPosEM, cdPosDataVault, cdPosLabelSecurity)
+}
+
+// focused returns the focus position of the focused field
+func (s *ConfigureDBStep) focused() int {
+	return s.positions()[s.focusIndex]
+}
+
+func (s *ConfigureDBStep) moveFocus(delta int) {
+	positions := s.positions()
+	if idx := s.focused(); idx < len(s.inputs) {
+		s.inputs[idx].Blur()
+	}
+	s.focusIndex = (s.focusIndex + delta + len(positions)) % len(positions)
+	if idx := s.focused(); idx < len(s.inputs) {
+		s.inputs[idx].Focus()
+	}
+}
+
+func (s *ConfigureDBStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.ConfigureSource)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *ConfigureDBStep) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.SubtitleStyle.Render("Select the database to configure:") + "\n\n")
+
+	b.WriteString(s.renderField("Database SID", s.inputs[cdIdxSID], cdIdxSID) + "\n")
+	b.WriteString("\n" + s.renderToggle("Use wallet for SYS credentials", s.useWallet, cdPosWallet, 'w'))
+	if s.useWallet {
+		b.WriteString(s.renderField("Wallet Location", s.inputs[cdIdxWalletLocation], cdIdxWalletLocation) + "\n")
+		b.WriteString(s.renderField("Wallet Alias (connect string of the credential)", s.inputs[cdIdxWalletAlias], cdIdxWalletAlias) + "\n")
+	} else {
+		b.WriteString(s.renderField("SYS Password (optional)", s.inputs[cdIdxSysPassword], cdIdxSysPassword) + "\n")
+	}
+
+	b.WriteString("\n" + ui.LabelStyle.Render("Current state of the database:") + "\n")
+	b.WriteString(ui.SubtitleStyle.Render("Only options changed on the following steps are generated") + "\n\n")
+
+	b.WriteString(s.renderToggle("ARCHIVELOG mode", s.current.EnableArchiveLog, cdPosArchive, 'a'))
+	b.WriteString(s.renderChoice(fmt.Sprintf("Enterprise Manager: %s", s.current.EMConfiguration), cdPosEM, 'e'))
+	b.WriteString(s.renderToggle("Database Vault enabled", s.current.EnableDataVault, cdPosDataVault, 'd'))
+	b.WriteString(s.renderToggle("Label Security enabled", s.current.EnableLabelSecurity, cdPosLabelSecurity, 'l'))
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+func (s *ConfigureDBStep) renderToggle(label string, checked bool, position int, key rune) string {
+	checkbox := ui.UncheckedStyle.String()
+	if checked {
+		checkbox = ui.CheckedStyle.String()
+	}
+	return checkbox + " " + s.renderChoice(label, position, key)
+}
+
+func (s *ConfigureDBStep) renderChoice(label string, position int, key rune) string {
+	style := ui.NormalItemStyle
+	hint := ""
+	if s.focused() == position {
+		style = ui.SelectedItemStyle
+		hint = ui.SubtitleStyle.Render(fmt.Sprintf("  (press '%c' to change)", key))
+	}
+	return style.Render(label) + hint + "\n"
+}
+
+func (s *ConfigureDBStep) renderField(label string, input textinput.Model, index int) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if s.focused() == index {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *ConfigureDBStep) Title() string {
+	return "Configure Database"
+}
+
+// Apply applies the step's changes to the config
+func (s *ConfigureDBStep) Apply(config *model.DBConfig) {
+	config.ConfigureSID = strings.TrimSpace(s.inputs[cdIdxSID].Value())
+	config.SysPassword = s.inputs[cdIdxSysPassword].Value()
+	config.UseOSAuthentication = config.SysPassword == ""
+	config.UseWallet = s.useWallet
+	config.WalletLocation = strings.TrimSpace(s.inputs[cdIdxWalletLocation].Value())
+	config.WalletAlias = strings.TrimSpace(s.inputs[cdIdxWalletAlias].Value())
+
+	// A different current state restarts the following steps from it; an
+	// unchanged one keeps the changes already made there
+	if s.current != config.Baseline() {
+		config.SetOptions(s.current)
+	}
+	baseline := s.current
+	config.ConfigureBaseline = &baseline
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *ConfigureDBStep) ShouldSkip(config *model.DBConfig) bool {
+	return config.Operation != model.OperationConfigure
+}
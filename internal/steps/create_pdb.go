@@ -0,0 +1,378 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CreatePDBStep handles the create pluggable database configuration
+type CreatePDBStep struct {
+	config     *model.DBConfig
+	sourceList ui.SelectList
+	inputs     []textinput.Model
+	phase      int // 0=PDB source, 1=PDB details
+	focusIndex int // Index into visibleFields, or the OMF toggle after them
+	useOMF     bool
+	err        string
+}
+
+const (
+	cpIdxCDB = iota
+	cpIdxPDBName
+	cpIdxSourcePDB
+	cpIdxXMLFile
+	cpIdxConvert
+	cpIdxDest
+	cpIdxAdminUser
+	cpIdxAdminPassword
+)
+
+// NewCreatePDBStep creates a new create PDB step
+func NewCreatePDBStep() *CreatePDBStep {
+	sourceItems := []ui.SelectItem{
+		{
+			Title:       "Create from the seed",
+			Description: "Create an empty PDB from PDB$SEED with a new local administrator",
+			Value:       string(model.PDBSourceSeed),
+		},
+		{
+			Title:       "Clone an existing PDB",
+			Description: "Copy a PDB of the same container database",
+			Value:       string(model.PDBSourceClone),
+		},
+		{
+			Title:       "Plug in from an XML file",
+			Description: "Plug in an unplugged PDB described by its XML metadata file",
+			Value:       string(model.PDBSourceXML),
+		},
+	}
+
+	s := &CreatePDBStep{
+		sourceList: ui.NewSelectList(sourceItems),
+		inputs:     make([]textinput.Model, 8),
+	}
+
+	placeholders := []string{
+		cpIdxCDB:           "orcl",
+		cpIdxPDBName:       "salespdb",
+		cpIdxSourcePDB:     "orclpdb",
+		cpIdxXMLFile:       "/u01/app/oracle/unplug/salespdb.xml",
+		cpIdxConvert:       "'/u01/oradata/ORCL/pdbseed/','/u01/oradata/ORCL/salespdb/'",
+		cpIdxDest:          "/u01/app/oracle/oradata",
+		cpIdxAdminUser:     "pdbadmin",
+		cpIdxAdminPassword: "PDB administrator password",
+	}
+	limits := []int{
+		cpIdxCDB:           12,
+		cpIdxPDBName:       30,
+		cpIdxSourcePDB:     30,
+		cpIdxXMLFile:       512,
+		cpIdxConvert:       1024,
+		cpIdxDest:          512,
+		cpIdxAdminUser:     128,
+		cpIdxAdminPassword: 30,
+	}
+	for i := range s.inputs {
+		s.inputs[i] = textinput.New()
+		s.inputs[i].Placeholder = placeholders[i]
+		s.inputs[i].CharLimit = limits[i]
+	}
+	s.inputs[cpIdxAdminPassword].EchoMode = textinput.EchoPassword
+	s.inputs[cpIdxAdminPassword].EchoCharacter = '*'
+
+	return s
+}
+
+// Init initializes the step
+func (s *CreatePDBStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.phase = 0
+	s.focusIndex = 0
+	s.err = ""
+	s.useOMF = config.PDBUseOMF
+	s.sourceList.Reset()
+
+	for i, item := range s.sourceList.Items {
+		if item.Value == string(config.PDBSource) {
+			s.sourceList.Cursor = i
+			break
+		}
+	}
+
+	s.inputs[cpIdxCDB].SetValue(config.SourceCDB)
+	s.inputs[cpIdxPDBName].SetValue(config.NewPDBName)
+	s.inputs[cpIdxSourcePDB].SetValue(config.SourcePDB)
+	s.inputs[cpIdxXMLFile].SetValue(config.PDBMetadataFile)
+	s.inputs[cpIdxConvert].SetValue(config.PDBFileNameConvert)
+	s.inputs[cpIdxDest].SetValue(config.PDBDatafileDestination)
+	s.inputs[cpIdxAdminUser].SetValue(config.PDBAdminUser)
+	s.inputs[cpIdxAdminPassword].SetValue(config.PDBAdminPassword)
+
+	return nil
+}
+
+// Update handles messages
+func (s *CreatePDBStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "esc" {
+		if s.phase > 0 {
+			s.phase = 0
+			s.err = ""
+			s.blurAll()
+			return s, wizard.StepStay, nil
+		}
+		return s, wizard.StepBack, nil
+	}
+
+	if s.phase == 0 {
+		return s.updateSource(msg)
+	}
+	return s.updateDetails(msg)
+}
+
+func (s *CreatePDBStep) updateSource(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter", " ":
+			s.sourceList.Update(msg)
+			if s.sourceList.IsSelected() {
+				s.phase = 1
+				s.focusIndex = 0
+				s.blurAll()
+				s.inputs[s.visibleFields()[0]].Focus()
+				return s, wizard.StepStay, textinput.Blink
+			}
+		default:
+			s.sourceList.Update(msg)
+		}
+	}
+	return s, wizard.StepStay, nil
+}
+
+func (s *CreatePDBStep) updateDetails(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	fields := s.visibleFields()
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+
+		case "o", "O":
+			// Toggle OMF when not in text input
+			if s.focusIndex == len(fields) {
+				s.useOMF = !s.useOMF
+			}
+		}
+	}
+
+	// Update the focused text input
+	if s.focusIndex < len(fields) {
+		var cmd tea.Cmd
+		idx := fields[s.focusIndex]
+		s.inputs[idx], cmd = s.inputs[idx].Update(msg)
+		return s, wizard.StepStay, cmd
+	}
+
+	return s, wizard.StepStay, nil
+}
+
+// visibleFields returns the inputs shown for the selected source and placement
+func (s *CreatePDBStep) visibleFields() []int {
+	fields := []int{cpIdxCDB, cpIdxPDBName}
+
+	source := s.source()
+	switch source {
+	case model.PDBSourceClone:
+		fields = append(fields, cpIdxSourcePDB)
+	case model.PDBSourceXML:
+		fields = append(fields, cpIdxXMLFile)
+	}
+
+	if s.useOMF {
+		fields = append(fields, cpIdxDest)
+	} else {
+		fields = append(fields, cpIdxConvert)
+	}
+
+	if source == model.PDBSourceSeed {
+		fields = append(fields, cpIdxAdminUser, cpIdxAdminPassword)
+	}
+
+	return fields
+}
+
+func (s *CreatePDBStep) source() model.PDBSource {
+	return model.PDBSource(s.sourceList.GetSelectedValue())
+}
+
+// moveFocus cycles through the visible inputs and the OMF toggle
+func (s *CreatePDBStep) moveFocus(delta int) {
+	fields := s.visibleFields()
+	positions := len(fields) + 1
+
+	s.blurAll()
+	s.focusIndex = (s.focusIndex + delta + positions) % positions
+	if s.focusIndex < len(fields) {
+		s.inputs[fields[s.focusIndex]].Focus()
+	}
+}
+
+func (s *CreatePDBStep) blurAll() {
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+}
+
+func (s *CreatePDBStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.CreatePDB)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *CreatePDBStep) View() string {
+	var b strings.Builder
+
+	if s.phase == 0 {
+		b.WriteString(ui.SubtitleStyle.Render("How should the pluggable database be created?") + "\n\n")
+		b.WriteString(s.sourceList.View())
+		return b.String()
+	}
+
+	b.WriteString(ui.SubtitleStyle.Render(s.sourceList.GetSelectedItem().Title) + "\n\n")
+
+	fields := s.visibleFields()
+	for i, idx := range fields {
+		b.WriteString(s.renderField(s.fieldLabel(idx), s.inputs[idx], s.focusIndex == i) + "\n")
+		if idx == cpIdxAdminPassword && s.focusIndex == i {
+			pending := *s.config
+			s.Apply(&pending)
+			b.WriteString(renderStrengthMeter(&pending, pending.PDBAdminPassword, pending.PDBAdminUser))
+		}
+	}
+
+	// OMF toggle
+	checkbox := ui.UncheckedStyle.String()
+	if s.useOMF {
+		checkbox = ui.CheckedStyle.String()
+	}
+	omfStyle := ui.NormalItemStyle
+	if s.focusIndex == len(fields) {
+		omfStyle = ui.SelectedItemStyle
+	}
+	b.WriteString(fmt.Sprintf("\n%s %s\n", checkbox, omfStyle.Render("Use Oracle Managed Files (OMF)")))
+	b.WriteString(ui.SubtitleStyle.Render("    Press 'o' to toggle") + "\n")
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+// fieldLabel returns the label of an input
+func (s *CreatePDBStep) fieldLabel(idx int) string {
+	switch idx {
+	case cpIdxCDB:
+		return "Container Database SID"
+	case cpIdxPDBName:
+		return "New PDB Name"
+	case cpIdxSourcePDB:
+		return "Source PDB to Clone"
+	case cpIdxXMLFile:
+		return "XML Metadata File"
+	case cpIdxConvert:
+		if s.source() == model.PDBSourceXML {
+			return "File Name Convert (source,target pairs; empty keeps XML locations)"
+		}
+		return "File Name Convert (source,target pairs)"
+	case cpIdxDest:
+		return "Datafile Destination (empty uses db_create_file_dest)"
+	case cpIdxAdminUser:
+		return "PDB Administrator"
+	default:
+		return "PDB Administrator Password"
+	}
+}
+
+func (s *CreatePDBStep) renderField(label string, input textinput.Model, focused bool) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if focused {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *CreatePDBStep) Title() string {
+	return "Create Pluggable Database"
+}
+
+// Apply applies the step's changes to the config
+func (s *CreatePDBStep) Apply(config *model.DBConfig) {
+	value := func(idx int) string {
+		return strings.TrimSpace(s.inputs[idx].Value())
+	}
+
+	config.PDBSource = s.source()
+	config.SourceCDB = value(cpIdxCDB)
+	config.NewPDBName = value(cpIdxPDBName)
+	config.PDBUseOMF = s.useOMF
+
+	config.SourcePDB = ""
+	config.PDBMetadataFile = ""
+	switch config.PDBSource {
+	case model.PDBSourceClone:
+		config.SourcePDB = value(cpIdxSourcePDB)
+	case model.PDBSourceXML:
+		config.PDBMetadataFile = value(cpIdxXMLFile)
+	}
+
+	if s.useOMF {
+		config.PDBDatafileDestination = value(cpIdxDest)
+		config.PDBFileNameConvert = ""
+	} else {
+		config.PDBFileNameConvert = value(cpIdxConvert)
+		config.PDBDatafileDestination = ""
+	}
+
+	if config.PDBSource == model.PDBSourceSeed {
+		config.PDBAdminUser = value(cpIdxAdminUser)
+		config.PDBAdminPassword = s.inputs[cpIdxAdminPassword].Value()
+	}
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *CreatePDBStep) ShouldSkip(config *model.DBConfig) bool {
+	return config.Operation != model.OperationCreatePDB
+}
@@ -0,0 +1,216 @@
+package steps
+
+import (
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CreateTemplateStep handles the create template from database configuration
+type CreateTemplateStep struct {
+	config           *model.DBConfig
+	inputs           []textinput.Model
+	focusIndex       int // Inputs first, then the include datafiles toggle
+	includeDatafiles bool
+	err              string
+}
+
+const (
+	ctIdxSource = iota
+	ctIdxSysPassword
+	ctIdxName
+)
+
+// NewCreateTemplateStep creates a new create template step
+func NewCreateTemplateStep() *CreateTemplateStep {
+	s := &CreateTemplateStep{
+		inputs: make([]textinput.Model, 3),
+	}
+
+	s.inputs[ctIdxSource] = textinput.New()
+	s.inputs[ctIdxSource].CharLimit = 256
+
+	s.inputs[ctIdxSysPassword] = textinput.New()
+	s.inputs[ctIdxSysPassword].EchoMode = textinput.EchoPassword
+	s.inputs[ctIdxSysPassword].EchoCharacter = '*'
+	s.inputs[ctIdxSysPassword].CharLimit = 30
+
+	s.inputs[ctIdxName] = textinput.New()
+	s.inputs[ctIdxName].Placeholder = "orcl_template"
+	s.inputs[ctIdxName].CharLimit = 64
+
+	return s
+}
+
+// Init initializes the step
+func (s *CreateTemplateStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.focusIndex = 0
+	s.err = ""
+	s.includeDatafiles = config.TemplateIncludeDatafiles
+
+	s.inputs[ctIdxSource].SetValue(config.TemplateSourceDB)
+	s.inputs[ctIdxSysPassword].SetValue(config.SysPassword)
+	s.inputs[ctIdxName].SetValue(config.NewTemplateName)
+	s.updatePlaceholders()
+
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+	s.inputs[0].Focus()
+
+	return textinput.Blink
+}
+
+// Update handles messages
+func (s *CreateTemplateStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return s, wizard.StepBack, nil
+
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+
+		case "x", "X":
+			// Toggle the datafiles when not in text input
+			if s.focusIndex == len(s.inputs) {
+				s.includeDatafiles = !s.includeDatafiles
+				s.updatePlaceholders()
+				return s, wizard.StepStay, nil
+			}
+		}
+	}
+
+	// Update the focused text input
+	if s.focusIndex < len(s.inputs) {
+		var cmd tea.Cmd
+		s.inputs[s.focusIndex], cmd = s.inputs[s.focusIndex].Update(msg)
+		return s, wizard.StepStay, cmd
+	}
+
+	return s, wizard.StepStay, nil
+}
+
+// updatePlaceholders describes the source and credentials the selected template kind needs
+func (s *CreateTemplateStep) updatePlaceholders() {
+	if s.includeDatafiles {
+		s.inputs[ctIdxSource].Placeholder = "orcl"
+		s.inputs[ctIdxSysPassword].Placeholder = "Empty for OS authentication"
+	} else {
+		s.inputs[ctIdxSource].Placeholder = "dbhost:1521:orcl"
+		s.inputs[ctIdxSysPassword].Placeholder = "Password"
+	}
+}
+
+func (s *CreateTemplateStep) moveFocus(delta int) {
+	positions := len(s.inputs) + 1
+	if s.focusIndex < len(s.inputs) {
+		s.inputs[s.focusIndex].Blur()
+	}
+	s.focusIndex = (s.focusIndex + delta + positions) % positions
+	if s.focusIndex < len(s.inputs) {
+		s.inputs[s.focusIndex].Focus()
+	}
+}
+
+func (s *CreateTemplateStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.CreateTemplate)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *CreateTemplateStep) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.SubtitleStyle.Render("Create a DBCA template from an existing database:") + "\n\n")
+
+	sourceLabel := "Source Database (host:port:SID or EZCONNECT)"
+	passwordLabel := "SYS Password"
+	if s.includeDatafiles {
+		sourceLabel = "Source Database SID (local)"
+		passwordLabel = "SYS Password (optional)"
+	}
+	b.WriteString(s.renderField(sourceLabel, s.inputs[ctIdxSource], ctIdxSource) + "\n")
+	b.WriteString(s.renderField(passwordLabel, s.inputs[ctIdxSysPassword], ctIdxSysPassword) + "\n")
+	b.WriteString(s.renderField("Template Name", s.inputs[ctIdxName], ctIdxName) + "\n")
+
+	// Include datafiles toggle
+	checkbox := ui.UncheckedStyle.String()
+	if s.includeDatafiles {
+		checkbox = ui.CheckedStyle.String()
+	}
+	style := ui.NormalItemStyle
+	if s.focusIndex == len(s.inputs) {
+		style = ui.SelectedItemStyle
+	}
+	b.WriteString("\n" + checkbox + " " + style.Render("Include datafiles (clone template)") + "\n")
+	b.WriteString(ui.SubtitleStyle.Render("    Press 'x' to toggle") + "\n")
+	if s.includeDatafiles {
+		b.WriteString(ui.SubtitleStyle.Render("    Backs up the datafiles with RMAN; new databases are restored from them") + "\n")
+	} else {
+		b.WriteString(ui.SubtitleStyle.Render("    Saves the structure only; new databases are created from scratch") + "\n")
+	}
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+func (s *CreateTemplateStep) renderField(label string, input textinput.Model, index int) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if s.focusIndex == index {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *CreateTemplateStep) Title() string {
+	return "Create Template"
+}
+
+// Apply applies the step's changes to the config
+func (s *CreateTemplateStep) Apply(config *model.DBConfig) {
+	config.TemplateSourceDB = strings.TrimSpace(s.inputs[ctIdxSource].Value())
+	config.SysPassword = s.inputs[ctIdxSysPassword].Value()
+	config.UseOSAuthentication = config.SysPassword == ""
+	config.NewTemplateName = strings.TrimSpace(s.inputs[ctIdxName].Value())
+	config.TemplateIncludeDatafiles = s.includeDatafiles
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *CreateTemplateStep) ShouldSkip(config *model.DBConfig) bool {
+	return config.Operation != model.OperationTemplate
+}
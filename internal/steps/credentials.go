@@ -1,12 +1,19 @@
 package steps
 
 import (
+	"fmt"
+	"os"
+	"slices"
 	"strings"
 
+	"dbca_tui/internal/generator"
 	"dbca_tui/internal/model"
+	"dbca_tui/internal/pwpolicy"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
+	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -16,67 +23,120 @@ import (
 type CredentialsStep struct {
 	config            *model.DBConfig
 	inputs            []textinput.Model
-	focusIndex        int
+	focusIndex        int // Index into positions
 	useCommonPassword bool
+	useWallet         bool
+	policy            string            // Name of the password policy
+	generated         []generatedSecret // Passwords offered for saving or copying
+	notice            string
 	err               string
 }
 
+// generatedSecret is a generated password and the script variable it is read from
+type generatedSecret struct {
+	variable string
+	value    string
+}
+
 const (
 	credIdxCommon = iota
 	credIdxSys
 	credIdxSystem
 	credIdxPDBAdmin
+	credIdxWalletLocation
+	credIdxWalletAlias
+)
+
+// Focus positions of the toggles and the policy selector after the inputs
+const (
+	credPosCommon = iota + 6
+	credPosWallet
+	credPosPolicy
 )
 
 // NewCredentialsStep creates a new credentials step
 func NewCredentialsStep() *CredentialsStep {
 	s := &CredentialsStep{
-		inputs: make([]textinput.Model, 4),
-	}
-
-	// Common Password
-	s.inputs[credIdxCommon] = textinput.New()
-	s.inputs[credIdxCommon].Placeholder = "Enter password for all accounts"
-	s.inputs[credIdxCommon].EchoMode = textinput.EchoPassword
-	s.inputs[credIdxCommon].EchoCharacter = '*'
-	s.inputs[credIdxCommon].CharLimit = 30
-
-	// SYS Password
-	s.inputs[credIdxSys] = textinput.New()
-	s.inputs[credIdxSys].Placeholder = "SYS password"
-	s.inputs[credIdxSys].EchoMode = textinput.EchoPassword
-	s.inputs[credIdxSys].EchoCharacter = '*'
-	s.inputs[credIdxSys].CharLimit = 30
-
-	// SYSTEM Password
-	s.inputs[credIdxSystem] = textinput.New()
-	s.inputs[credIdxSystem].Placeholder = "SYSTEM password"
-	s.inputs[credIdxSystem].EchoMode = textinput.EchoPassword
-	s.inputs[credIdxSystem].EchoCharacter = '*'
-	s.inputs[credIdxSystem].CharLimit = 30
-
-	// PDBADMIN Password
-	s.inputs[credIdxPDBAdmin] = textinput.New()
-	s.inputs[credIdxPDBAdmin].Placeholder = "PDB Admin password"
-	s.inputs[credIdxPDBAdmin].EchoMode = textinput.EchoPassword
-	s.inputs[credIdxPDBAdmin].EchoCharacter = '*'
-	s.inputs[credIdxPDBAdmin].CharLimit = 30
+		inputs: make([]textinput.Model, 6),
+	}
+
+	s.inputs[credIdxCommon] = newPasswordInput("Enter password for all accounts")
+	s.inputs[credIdxSys] = newPasswordInput("SYS password")
+	s.inputs[credIdxSystem] = newPasswordInput("SYSTEM password")
+	s.inputs[credIdxPDBAdmin] = newPasswordInput("PDB Admin password")
+	s.inputs[credIdxWalletLocation], s.inputs[credIdxWalletAlias] = newWalletInputs()
 
 	return s
 }
 
+// newPasswordInput creates a masked input for a database account password
+func newPasswordInput(placeholder string) textinput.Model {
+	input := textinput.New()
+	input.Placeholder = placeholder
+	input.EchoMode = textinput.EchoPassword
+	input.EchoCharacter = '*'
+	input.CharLimit = 30
+	return input
+}
+
+// renderStrengthMeter rates a new password as it is typed and names the
+// first rule of the password policy it breaks. The config must hold the
+// pending values of the step, as the password must not contain the names
+// of the database or the accounts.
+func renderStrengthMeter(config *model.DBConfig, password string, usernames ...string) string {
+	policy := validation.PasswordPolicy(config)
+	if password == "" {
+		return ui.SubtitleStyle.Render(fmt.Sprintf("    %s: %s", policy.Function, policy.Description)) + "\n"
+	}
+
+	score := pwpolicy.Strength(password)
+	style := ui.ErrorStyle
+	switch {
+	case score >= 3:
+		style = ui.SuccessStyle
+	case score == 2:
+		style = ui.WarningStyle
+	}
+	bar := strings.Repeat("■", score) + strings.Repeat("□", 4-score)
+	meter := "    Strength: " + style.Render(bar+" "+pwpolicy.StrengthLabel(score)) + "\n"
+
+	if msg := validation.PasswordProblem(config, password, usernames...); msg != "" {
+		meter += ui.WarningStyle.Render("    "+msg) + "\n"
+	}
+	return meter
+}
+
+// newWalletInputs creates the inputs of the Oracle wallet holding the SYS credentials
+func newWalletInputs() (location, alias textinput.Model) {
+	location = textinput.New()
+	location.Placeholder = "/u01/app/oracle/admin/wallet"
+	location.CharLimit = 256
+
+	alias = textinput.New()
+	alias.Placeholder = "orcl (optional)"
+	alias.CharLimit = 128
+
+	return location, alias
+}
+
 // Init initializes the step
 func (s *CredentialsStep) Init(config *model.DBConfig) tea.Cmd {
 	s.config = config
 	s.focusIndex = 0
 	s.err = ""
 	s.useCommonPassword = config.UseCommonPassword
+	s.useWallet = config.UseWallet
+	s.policy = validation.PasswordPolicy(config).Name
+	s.generated = nil
+	s.notice = ""
 
 	// Set values from config
 	s.inputs[credIdxCommon].SetValue(config.CommonPassword)
 	s.inputs[credIdxSys].SetValue(config.SysPassword)
 	s.inputs[credIdxSystem].SetValue(config.SystemPassword)
 	s.inputs[credIdxPDBAdmin].SetValue(config.PDBAdminPassword)
+	s.inputs[credIdxWalletLocation].SetValue(config.WalletLocation)
+	s.inputs[credIdxWalletAlias].SetValue(config.WalletAlias)
 
 	// Reset focus
 	for i := range s.inputs {
@@ -88,6 +148,22 @@ func (s *CredentialsStep) Init(config *model.DBConfig) tea.Cmd {
 
 // Update handles messages
 func (s *CredentialsStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	// Generated passwords are offered once; any other key dismisses the offer
+	if msg, ok := msg.(tea.KeyMsg); ok && s.generated != nil {
+		switch msg.String() {
+		case "f", "F":
+			s.savePasswords()
+			return s, wizard.StepStay, nil
+		case "y", "Y":
+			s.copyPasswords()
+			return s, wizard.StepStay, nil
+		case "esc":
+			s.generated = nil
+			return s, wizard.StepStay, nil
+		}
+		s.generated = nil
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -95,11 +171,11 @@ func (s *CredentialsStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, t
 			return s, wizard.StepBack, nil
 
 		case "tab", "down":
-			s.nextField()
+			s.moveFocus(1)
 			return s, wizard.StepStay, nil
 
 		case "shift+tab", "up":
-			s.prevField()
+			s.moveFocus(-1)
 			return s, wizard.StepStay, nil
 
 		case "enter":
@@ -110,23 +186,36 @@ func (s *CredentialsStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, t
 
 		case "c", "C":
 			// Toggle common password mode
-			if s.focusIndex == 0 {
+			if s.focused() == credPosCommon {
 				s.useCommonPassword = !s.useCommonPassword
-				if s.useCommonPassword {
-					s.focusIndex = 1
-					s.inputs[credIdxCommon].Focus()
-				} else {
-					s.focusIndex = 1
-					s.inputs[credIdxSys].Focus()
-				}
+				s.focusIndex = 2
+				s.inputs[s.focused()].Focus()
 				return s, wizard.StepStay, textinput.Blink
 			}
+
+		case "w", "W":
+			// Toggle the wallet; the common password toggle is hidden with it
+			if s.focused() == credPosWallet {
+				s.useWallet = !s.useWallet
+				s.focusIndex = slices.Index(s.positions(), credPosWallet)
+				return s, wizard.StepStay, nil
+			}
+
+		case "ctrl+g":
+			s.generatePasswords()
+			return s, wizard.StepStay, nil
+
+		case "p", "P":
+			// Cycle the password policy
+			if s.focused() == credPosPolicy {
+				s.cyclePolicy()
+				return s, wizard.StepStay, nil
+			}
 		}
 	}
 
 	// Update the focused text input
-	inputIdx := s.getInputIndex()
-	if inputIdx >= 0 {
+	if inputIdx := s.focused(); inputIdx < len(s.inputs) {
 		var cmd tea.Cmd
 		s.inputs[inputIdx], cmd = s.inputs[inputIdx].Update(msg)
 		return s, wizard.StepStay, cmd
@@ -135,113 +224,184 @@ func (s *CredentialsStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, t
 	return s, wizard.StepStay, nil
 }
 
-func (s *CredentialsStep) getInputIndex() int {
-	if s.focusIndex == 0 {
-		return -1 // Toggle
+// positions returns the focus positions in display order for the current settings
+func (s *CredentialsStep) positions() []int {
+	var positions []int
+	switch {
+	case s.useWallet:
+		positions = []int{credPosWallet, credIdxWalletLocation, credIdxWalletAlias}
+	case s.useCommonPassword:
+		positions = []int{credPosCommon, credPosWallet, credIdxCommon}
+	default:
+		positions = []int{credPosCommon, credPosWallet, credIdxSys, credIdxSystem}
+	}
+	if s.config.CreateAsContainerDB && (s.useWallet || !s.useCommonPassword) {
+		positions = append(positions, credIdxPDBAdmin)
 	}
+	return append(positions, credPosPolicy)
+}
 
-	if s.useCommonPassword {
-		if s.focusIndex == 1 {
-			return credIdxCommon
-		}
-		return -1
+// cyclePolicy selects the next password policy
+func (s *CredentialsStep) cyclePolicy() {
+	i := slices.IndexFunc(pwpolicy.Policies, func(p pwpolicy.Policy) bool { return p.Name == s.policy })
+	s.policy = pwpolicy.Policies[(i+1)%len(pwpolicy.Policies)].Name
+}
+
+// focused returns the focus position of the focused field
+func (s *CredentialsStep) focused() int {
+	return s.positions()[s.focusIndex]
+}
+
+func (s *CredentialsStep) moveFocus(delta int) {
+	positions := s.positions()
+	if inputIdx := s.focused(); inputIdx < len(s.inputs) {
+		s.inputs[inputIdx].Blur()
 	}
+	s.focusIndex = (s.focusIndex + delta + len(positions)) % len(positions)
+	if inputIdx := s.focused(); inputIdx < len(s.inputs) {
+		s.inputs[inputIdx].Focus()
+	}
+}
 
-	// Different passwords mode
-	switch s.focusIndex {
-	case 1:
-		return credIdxSys
-	case 2:
-		return credIdxSystem
-	case 3:
-		if s.config.CreateAsContainerDB {
-			return credIdxPDBAdmin
+// passwordInputs returns the visible inputs of passwords for new accounts
+func (s *CredentialsStep) passwordInputs() []int {
+	var inputs []int
+	for _, pos := range s.positions() {
+		switch pos {
+		case credIdxCommon, credIdxSys, credIdxSystem, credIdxPDBAdmin:
+			inputs = append(inputs, pos)
 		}
 	}
-	return -1
+	return inputs
 }
 
-func (s *CredentialsStep) getMaxFields() int {
-	if s.useCommonPassword {
-		return 1 // toggle + common password
+// accountNames returns the accounts the password of an input is set for
+func (s *CredentialsStep) accountNames(inputIdx int) []string {
+	switch inputIdx {
+	case credIdxCommon:
+		return []string{"SYS", "SYSTEM", "PDBADMIN"}
+	case credIdxSys:
+		return []string{"SYS"}
+	case credIdxSystem:
+		return []string{"SYSTEM"}
+	case credIdxPDBAdmin:
+		return []string{"PDBADMIN"}
+	default:
+		return nil
 	}
-	if s.config.CreateAsContainerDB {
-		return 3 // toggle + sys + system + pdbadmin
+}
+
+// scriptVariables returns the variables saved scripts read the password of an input from
+func (s *CredentialsStep) scriptVariables(inputIdx int) []string {
+	switch inputIdx {
+	case credIdxCommon:
+		if s.config.CreateAsContainerDB {
+			return []string{"SYS_PASSWORD", "SYSTEM_PASSWORD", "PDB_ADMIN_PASSWORD"}
+		}
+		return []string{"SYS_PASSWORD", "SYSTEM_PASSWORD"}
+	case credIdxSys:
+		return []string{"SYS_PASSWORD"}
+	case credIdxSystem:
+		return []string{"SYSTEM_PASSWORD"}
+	default:
+		return []string{"PDB_ADMIN_PASSWORD"}
 	}
-	return 2 // toggle + sys + system
 }
 
-func (s *CredentialsStep) nextField() {
-	inputIdx := s.getInputIndex()
-	if inputIdx >= 0 {
-		s.inputs[inputIdx].Blur()
+// generatePasswords fills every visible password input with a random
+// password complying with the policy and offers to save or copy them
+func (s *CredentialsStep) generatePasswords() {
+	s.err = ""
+	s.notice = ""
+
+	pending := *s.config
+	s.Apply(&pending)
+
+	var secrets []generatedSecret
+	for _, idx := range s.passwordInputs() {
+		pwd, err := validation.GeneratePassword(&pending, s.accountNames(idx)...)
+		if err != nil {
+			s.err = fmt.Sprintf("Error generating password: %v", err)
+			return
+		}
+		s.inputs[idx].SetValue(pwd)
+		for _, variable := range s.scriptVariables(idx) {
+			secrets = append(secrets, generatedSecret{variable: variable, value: pwd})
+		}
 	}
 
-	maxFields := s.getMaxFields()
-	s.focusIndex++
-	if s.focusIndex > maxFields {
-		s.focusIndex = 0
+	if len(secrets) == 0 {
+		s.err = "No password to generate; the wallet holds the SYS and SYSTEM credentials"
+		return
 	}
+	s.generated = secrets
+}
 
-	newInputIdx := s.getInputIndex()
-	if newInputIdx >= 0 {
-		s.inputs[newInputIdx].Focus()
+// passwordsFileName returns the file generated passwords are saved to
+func (s *CredentialsStep) passwordsFileName() string {
+	return fmt.Sprintf("dbca_%s_passwords.env", s.config.SID)
+}
+
+// passwordsFile renders the generated passwords as exports that a saved
+// script reading its passwords from the environment picks up
+func (s *CredentialsStep) passwordsFile() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("# Passwords generated for %s\n", s.config.GlobalDBName))
+	b.WriteString(fmt.Sprintf("# Load with: . ./%s\n", s.passwordsFileName()))
+	for _, secret := range s.generated {
+		b.WriteString(fmt.Sprintf("export %s=%s\n", secret.variable, generator.QuotePOSIX(secret.value)))
 	}
+	return b.String()
 }
 
-func (s *CredentialsStep) prevField() {
-	inputIdx := s.getInputIndex()
-	if inputIdx >= 0 {
-		s.inputs[inputIdx].Blur()
+// savePasswords writes the generated passwords to a file only the owner can read
+func (s *CredentialsStep) savePasswords() {
+	filename := s.passwordsFileName()
+
+	if err := writePrivateFile(filename, s.passwordsFile()); err != nil {
+		s.err = fmt.Sprintf("Error saving file: %v", err)
+		return
 	}
+	s.generated = nil
+	s.err = ""
+	s.notice = fmt.Sprintf("Passwords saved to %s", filename)
+}
 
-	maxFields := s.getMaxFields()
-	s.focusIndex--
-	if s.focusIndex < 0 {
-		s.focusIndex = maxFields
+// writePrivateFile writes content to a file only the owner can read. An
+// existing file keeps its mode when opened, so it is restricted before
+// anything is written to it.
+func writePrivateFile(filename, content string) error {
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := f.Chmod(0600); err != nil {
+		f.Close()
+		return err
 	}
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
 
-	newInputIdx := s.getInputIndex()
-	if newInputIdx >= 0 {
-		s.inputs[newInputIdx].Focus()
+// copyPasswords puts the generated passwords on the system clipboard
+func (s *CredentialsStep) copyPasswords() {
+	if err := clipboard.WriteAll(s.passwordsFile()); err != nil {
+		s.err = fmt.Sprintf("Error copying to the clipboard: %v", err)
+		return
 	}
+	s.generated = nil
+	s.err = ""
+	s.notice = "Passwords copied to the clipboard"
 }
 
 func (s *CredentialsStep) validate() bool {
 	s.err = ""
 
-	if s.useCommonPassword {
-		pwd := s.inputs[credIdxCommon].Value()
-		if pwd == "" {
-			s.err = "Password is required"
-			return false
-		}
-		if len(pwd) < 8 {
-			s.err = "Password must be at least 8 characters"
-			return false
-		}
-	} else {
-		if s.inputs[credIdxSys].Value() == "" {
-			s.err = "SYS password is required"
-			return false
-		}
-		if s.inputs[credIdxSystem].Value() == "" {
-			s.err = "SYSTEM password is required"
-			return false
-		}
-		if s.config.CreateAsContainerDB && s.inputs[credIdxPDBAdmin].Value() == "" {
-			s.err = "PDB Admin password is required"
-			return false
-		}
-
-		// Check minimum length
-		if len(s.inputs[credIdxSys].Value()) < 8 {
-			s.err = "SYS password must be at least 8 characters"
-			return false
-		}
-	}
-
-	return true
+	s.err = validateStep(s, s.config, validation.Credentials)
+	return s.err == ""
 }
 
 // View renders the step
@@ -250,29 +410,46 @@ func (s *CredentialsStep) View() string {
 
 	b.WriteString(ui.SubtitleStyle.Render("Configure database credentials:") + "\n\n")
 
-	// Password mode toggle
-	checkbox := ui.UncheckedStyle.String()
-	if s.useCommonPassword {
-		checkbox = ui.CheckedStyle.String()
+	// Password mode toggles
+	if !s.useWallet {
+		b.WriteString(s.renderToggle("Use same password for all accounts", s.useCommonPassword, credPosCommon, 'c'))
+	}
+	b.WriteString(s.renderToggle("Use wallet for SYS and SYSTEM credentials", s.useWallet, credPosWallet, 'w'))
+
+	switch {
+	case s.useWallet:
+		b.WriteString(s.renderField("Wallet Location", credIdxWalletLocation))
+		b.WriteString(s.renderField("Wallet Alias (connect string of the credential)", credIdxWalletAlias))
+	case s.useCommonPassword:
+		b.WriteString(s.renderField("Password for all accounts (SYS, SYSTEM, PDBADMIN)", credIdxCommon))
+	default:
+		b.WriteString(s.renderField("SYS Password", credIdxSys))
+		b.WriteString(s.renderField("SYSTEM Password", credIdxSystem))
 	}
-	toggleStyle := ui.NormalItemStyle
-	if s.focusIndex == 0 {
-		toggleStyle = ui.SelectedItemStyle
+	if slices.Contains(s.positions(), credIdxPDBAdmin) {
+		b.WriteString(s.renderField("PDB Admin Password", credIdxPDBAdmin))
 	}
-	b.WriteString(checkbox + " " + toggleStyle.Render("Use same password for all accounts") + "\n")
-	b.WriteString(ui.SubtitleStyle.Render("    Press 'c' to toggle") + "\n\n")
 
-	if s.useCommonPassword {
-		b.WriteString(s.renderField("Password for all accounts (SYS, SYSTEM, PDBADMIN)", s.inputs[credIdxCommon], s.focusIndex == 1))
+	// Password policy selector
+	policy, _ := pwpolicy.Lookup(s.policy)
+	style := ui.NormalItemStyle
+	if s.focused() == credPosPolicy {
+		style = ui.SelectedItemStyle
+	}
+	b.WriteString(style.Render("Password policy: "+policy.Function) + "\n")
+	b.WriteString(ui.SubtitleStyle.Render("    "+policy.Description) + "\n")
+	b.WriteString(ui.SubtitleStyle.Render("    Press 'p' to change") + "\n")
+
+	if s.generated != nil {
+		b.WriteString("\n" + ui.SuccessStyle.Render("Generated passwords for the accounts above") + "\n")
+		b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("    Press 'f' to save them to %s (mode 0600), 'y' to copy them to the clipboard, Esc to dismiss",
+			s.passwordsFileName())) + "\n")
 	} else {
-		b.WriteString(s.renderField("SYS Password", s.inputs[credIdxSys], s.focusIndex == 1))
-		b.WriteString(s.renderField("SYSTEM Password", s.inputs[credIdxSystem], s.focusIndex == 2))
-		if s.config.CreateAsContainerDB {
-			b.WriteString(s.renderField("PDB Admin Password", s.inputs[credIdxPDBAdmin], s.focusIndex == 3))
-		}
+		b.WriteString("\n" + ui.SubtitleStyle.Render("Press ctrl+g to generate passwords") + "\n")
+	}
+	if s.notice != "" {
+		b.WriteString(ui.SuccessStyle.Render(s.notice) + "\n")
 	}
-
-	b.WriteString("\n" + ui.SubtitleStyle.Render("Password requirements: minimum 8 characters") + "\n")
 
 	if s.err != "" {
 		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
@@ -283,18 +460,40 @@ func (s *CredentialsStep) View() string {
 	return b.String()
 }
 
-func (s *CredentialsStep) renderField(label string, input textinput.Model, focused bool) string {
+// renderToggle renders a password mode checkbox with its key
+func (s *CredentialsStep) renderToggle(label string, checked bool, position int, key rune) string {
+	checkbox := ui.UncheckedStyle.String()
+	if checked {
+		checkbox = ui.CheckedStyle.String()
+	}
+	style := ui.NormalItemStyle
+	if s.focused() == position {
+		style = ui.SelectedItemStyle
+	}
+	return checkbox + " " + style.Render(label) + "\n" +
+		ui.SubtitleStyle.Render(fmt.Sprintf("    Press '%c' to toggle", key)) + "\n\n"
+}
+
+// renderField renders an input; a focused password input gets a strength meter
+func (s *CredentialsStep) renderField(label string, inputIdx int) string {
 	labelStyle := ui.LabelStyle
 	inputStyle := ui.InputStyle
 
+	focused := s.focused() == inputIdx
 	if focused {
 		inputStyle = ui.FocusedInputStyle
 	}
 
-	return lipgloss.JoinVertical(lipgloss.Left,
+	field := lipgloss.JoinVertical(lipgloss.Left,
 		labelStyle.Render(label),
-		inputStyle.Render(input.View()),
-	) + "\n\n"
+		inputStyle.Render(s.inputs[inputIdx].View()),
+	) + "\n"
+	if usernames := s.accountNames(inputIdx); focused && usernames != nil {
+		pending := *s.config
+		s.Apply(&pending)
+		field += renderStrengthMeter(&pending, s.inputs[inputIdx].Value(), usernames...)
+	}
+	return field + "\n"
 }
 
 // Title returns the step title
@@ -304,13 +503,25 @@ func (s *CredentialsStep) Title() string {
 
 // Apply applies the step's changes to the config
 func (s *CredentialsStep) Apply(config *model.DBConfig) {
+	config.PasswordPolicy = s.policy
+	config.UseWallet = s.useWallet
+	config.WalletLocation = strings.TrimSpace(s.inputs[credIdxWalletLocation].Value())
+	config.WalletAlias = strings.TrimSpace(s.inputs[credIdxWalletAlias].Value())
+
+	// The wallet holds SYS and SYSTEM; only the PDB administrator is typed
+	if s.useWallet {
+		config.UseCommonPassword = false
+		if config.CreateAsContainerDB {
+			config.PDBAdminPassword = s.inputs[credIdxPDBAdmin].Value()
+		}
+		return
+	}
+
 	config.UseCommonPassword = s.useCommonPassword
 
 	if s.useCommonPassword {
 		config.CommonPassword = s.inputs[credIdxCommon].Value()
-		config.SysPassword = config.CommonPassword
-		config.SystemPassword = config.CommonPassword
-		config.PDBAdminPassword = config.CommonPassword
+		config.ApplyCommonPassword()
 	} else {
 		config.SysPassword = s.inputs[credIdxSys].Value()
 		config.SystemPassword = s.inputs[credIdxSystem].Value()
@@ -1,10 +1,12 @@
 package steps
 
 import (
+	"fmt"
 	"strings"
 
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -12,24 +14,27 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// DataVaultStep handles Oracle Data Vault configuration
+// DataVaultStep handles Oracle Data Vault and Label Security configuration
 type DataVaultStep struct {
-	config          *model.DBConfig
-	inputs          []textinput.Model
-	focusIndex      int
-	enableDataVault bool
-	err             string
+	config              *model.DBConfig
+	inputs              []textinput.Model
+	focusIndex          int // 0=Data Vault toggle, then visibleFields, then the Label Security toggle
+	enableDataVault     bool
+	enableLabelSecurity bool
+	err                 string
 }
 
 const (
 	dvIdxOwner = iota
+	dvIdxOwnerPassword
 	dvIdxAccountManager
+	dvIdxAccountManagerPassword
 )
 
 // NewDataVaultStep creates a new Data Vault step
 func NewDataVaultStep() *DataVaultStep {
 	s := &DataVaultStep{
-		inputs: make([]textinput.Model, 2),
+		inputs: make([]textinput.Model, 4),
 	}
 
 	// Data Vault Owner
@@ -42,6 +47,15 @@ func NewDataVaultStep() *DataVaultStep {
 	s.inputs[dvIdxAccountManager].Placeholder = "C##DVACCTMGR"
 	s.inputs[dvIdxAccountManager].CharLimit = 30
 
+	// Passwords of the accounts, needed when configuring an existing database
+	for _, idx := range []int{dvIdxOwnerPassword, dvIdxAccountManagerPassword} {
+		s.inputs[idx] = textinput.New()
+		s.inputs[idx].Placeholder = "Password"
+		s.inputs[idx].EchoMode = textinput.EchoPassword
+		s.inputs[idx].EchoCharacter = '*'
+		s.inputs[idx].CharLimit = 30
+	}
+
 	return s
 }
 
@@ -51,21 +65,25 @@ func (s *DataVaultStep) Init(config *model.DBConfig) tea.Cmd {
 	s.focusIndex = 0
 	s.err = ""
 	s.enableDataVault = config.EnableDataVault
+	s.enableLabelSecurity = config.EnableLabelSecurity
 
 	// Set values from config
 	s.inputs[dvIdxOwner].SetValue(config.DataVaultOwner)
+	s.inputs[dvIdxOwnerPassword].SetValue(config.DataVaultOwnerPassword)
 	s.inputs[dvIdxAccountManager].SetValue(config.DataVaultAccountManager)
+	s.inputs[dvIdxAccountManagerPassword].SetValue(config.DataVaultAccountManagerPassword)
 
 	// Reset focus
-	for i := range s.inputs {
-		s.inputs[i].Blur()
-	}
+	s.blurAll()
 
 	return nil
 }
 
 // Update handles messages
 func (s *DataVaultStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	fields := s.visibleFields()
+	inInput := s.focusIndex > 0 && s.focusIndex <= len(fields)
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -73,11 +91,11 @@ func (s *DataVaultStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea
 			return s, wizard.StepBack, nil
 
 		case "tab", "down":
-			s.nextField()
+			s.moveFocus(1)
 			return s, wizard.StepStay, nil
 
 		case "shift+tab", "up":
-			s.prevField()
+			s.moveFocus(-1)
 			return s, wizard.StepStay, nil
 
 		case "enter":
@@ -87,92 +105,85 @@ func (s *DataVaultStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea
 			return s, wizard.StepStay, nil
 
 		case "d", "D":
-			// Toggle Data Vault
-			if s.focusIndex == 0 || !s.enableDataVault {
+			// Toggle Data Vault when not in text input
+			if !inInput {
 				s.enableDataVault = !s.enableDataVault
-				if s.enableDataVault {
+				s.blurAll()
+				s.focusIndex = 0
+				if fields := s.visibleFields(); len(fields) > 0 {
 					s.focusIndex = 1
-					s.inputs[dvIdxOwner].Focus()
-				} else {
-					for i := range s.inputs {
-						s.inputs[i].Blur()
-					}
-					s.focusIndex = 0
+					s.inputs[fields[0]].Focus()
 				}
 				return s, wizard.StepStay, textinput.Blink
 			}
+
+		case "l", "L":
+			// Toggle Label Security when not in text input
+			if !inInput {
+				s.enableLabelSecurity = !s.enableLabelSecurity
+			}
 		}
 	}
 
 	// Update the focused text input
-	if s.enableDataVault && s.focusIndex > 0 && s.focusIndex <= len(s.inputs) {
-		inputIdx := s.focusIndex - 1
+	if inInput {
 		var cmd tea.Cmd
-		s.inputs[inputIdx], cmd = s.inputs[inputIdx].Update(msg)
+		idx := fields[s.focusIndex-1]
+		s.inputs[idx], cmd = s.inputs[idx].Update(msg)
 		return s, wizard.StepStay, cmd
 	}
 
 	return s, wizard.StepStay, nil
 }
 
-func (s *DataVaultStep) nextField() {
-	if s.focusIndex > 0 && s.focusIndex <= len(s.inputs) {
-		s.inputs[s.focusIndex-1].Blur()
+// visibleFields returns the Data Vault inputs shown for the current settings
+func (s *DataVaultStep) visibleFields() []int {
+	if !s.enableDataVault {
+		return nil
 	}
-
-	maxFields := 0 // Just the toggle
-	if s.enableDataVault {
-		maxFields = len(s.inputs) // toggle + inputs
-	}
-
-	s.focusIndex++
-	if s.focusIndex > maxFields {
-		s.focusIndex = 0
+	if !s.configuring() {
+		return []int{dvIdxOwner, dvIdxAccountManager}
 	}
-
-	if s.focusIndex > 0 && s.focusIndex <= len(s.inputs) {
-		s.inputs[s.focusIndex-1].Focus()
+	if s.config.Baseline().EnableDataVault {
+		// Already enabled on the database; nothing to set up
+		return nil
 	}
+	return []int{dvIdxOwner, dvIdxOwnerPassword, dvIdxAccountManager, dvIdxAccountManagerPassword}
 }
 
-func (s *DataVaultStep) prevField() {
-	if s.focusIndex > 0 && s.focusIndex <= len(s.inputs) {
-		s.inputs[s.focusIndex-1].Blur()
-	}
+// configuring returns true when an existing database is being configured
+func (s *DataVaultStep) configuring() bool {
+	return s.config.Operation == model.OperationConfigure
+}
 
-	maxFields := 0
-	if s.enableDataVault {
-		maxFields = len(s.inputs)
-	}
+// moveFocus cycles through the Data Vault toggle, the visible inputs and the
+// Label Security toggle
+func (s *DataVaultStep) moveFocus(delta int) {
+	fields := s.visibleFields()
+	positions := len(fields) + 2
 
-	s.focusIndex--
-	if s.focusIndex < 0 {
-		s.focusIndex = maxFields
+	s.blurAll()
+	s.focusIndex = (s.focusIndex + delta + positions) % positions
+	if s.focusIndex > 0 && s.focusIndex <= len(fields) {
+		s.inputs[fields[s.focusIndex-1]].Focus()
 	}
+}
 
-	if s.focusIndex > 0 && s.focusIndex <= len(s.inputs) {
-		s.inputs[s.focusIndex-1].Focus()
+func (s *DataVaultStep) blurAll() {
+	for i := range s.inputs {
+		s.inputs[i].Blur()
 	}
 }
 
 func (s *DataVaultStep) validate() bool {
 	s.err = ""
 
-	if s.enableDataVault {
-		owner := strings.TrimSpace(s.inputs[dvIdxOwner].Value())
-		if owner == "" {
-			s.err = "Data Vault Owner is required"
-			return false
-		}
-
-		acctMgr := strings.TrimSpace(s.inputs[dvIdxAccountManager].Value())
-		if acctMgr == "" {
-			s.err = "Data Vault Account Manager is required"
-			return false
-		}
+	if s.configuring() {
+		s.err = validateStep(s, s.config, validation.ConfigureSecurity)
+	} else {
+		s.err = validateStep(s, s.config, validation.DataVault)
 	}
-
-	return true
+	return s.err == ""
 }
 
 // View renders the step
@@ -185,25 +196,21 @@ func (s *DataVaultStep) View() string {
 	b.WriteString(ui.SubtitleStyle.Render("to data by privileged database users.") + "\n\n")
 
 	// Enable Data Vault toggle
-	checkbox := ui.UncheckedStyle.String()
-	if s.enableDataVault {
-		checkbox = ui.CheckedStyle.String()
-	}
-	dvStyle := ui.NormalItemStyle
-	if s.focusIndex == 0 {
-		dvStyle = ui.SelectedItemStyle
-	}
-	b.WriteString(checkbox + " " + dvStyle.Render("Enable Oracle Data Vault") + "\n")
+	b.WriteString(s.renderToggle("Enable Oracle Data Vault", s.enableDataVault, s.focusIndex == 0))
 	b.WriteString(ui.SubtitleStyle.Render("    Press 'd' to toggle") + "\n\n")
 
-	if s.enableDataVault {
-		// Data Vault Owner
-		b.WriteString(s.renderField("Data Vault Owner", s.inputs[dvIdxOwner], 1) + "\n")
-
-		// Data Vault Account Manager
-		b.WriteString(s.renderField("Data Vault Account Manager", s.inputs[dvIdxAccountManager], 2) + "\n")
+	fields := s.visibleFields()
+	for i, idx := range fields {
+		b.WriteString(s.renderField(s.fieldLabel(idx), s.inputs[idx], i+1) + "\n")
+		if s.focusIndex == i+1 {
+			b.WriteString(s.renderStrengthMeter(idx))
+		}
 	}
 
+	// Enable Label Security toggle
+	b.WriteString(s.renderToggle("Enable Oracle Label Security", s.enableLabelSecurity, s.focusIndex == len(fields)+1))
+	b.WriteString(ui.SubtitleStyle.Render("    Press 'l' to toggle") + "\n")
+
 	if s.err != "" {
 		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
 	}
@@ -213,6 +220,47 @@ func (s *DataVaultStep) View() string {
 	return b.String()
 }
 
+// renderStrengthMeter rates the focused password of a Data Vault account
+func (s *DataVaultStep) renderStrengthMeter(idx int) string {
+	pending := *s.config
+	s.Apply(&pending)
+
+	switch idx {
+	case dvIdxOwnerPassword:
+		return renderStrengthMeter(&pending, pending.DataVaultOwnerPassword, pending.DataVaultOwner)
+	case dvIdxAccountManagerPassword:
+		return renderStrengthMeter(&pending, pending.DataVaultAccountManagerPassword, pending.DataVaultAccountManager)
+	default:
+		return ""
+	}
+}
+
+// fieldLabel returns the label of an input
+func (s *DataVaultStep) fieldLabel(idx int) string {
+	switch idx {
+	case dvIdxOwner:
+		return "Data Vault Owner"
+	case dvIdxOwnerPassword:
+		return "Data Vault Owner Password"
+	case dvIdxAccountManager:
+		return "Data Vault Account Manager"
+	default:
+		return "Data Vault Account Manager Password"
+	}
+}
+
+func (s *DataVaultStep) renderToggle(label string, checked, focused bool) string {
+	checkbox := ui.UncheckedStyle.String()
+	if checked {
+		checkbox = ui.CheckedStyle.String()
+	}
+	style := ui.NormalItemStyle
+	if focused {
+		style = ui.SelectedItemStyle
+	}
+	return fmt.Sprintf("%s %s\n", checkbox, style.Render(label))
+}
+
 func (s *DataVaultStep) renderField(label string, input textinput.Model, fieldIndex int) string {
 	labelStyle := ui.LabelStyle
 	inputStyle := ui.InputStyle
@@ -235,15 +283,31 @@ func (s *DataVaultStep) Title() string {
 // Apply applies the step's changes to the config
 func (s *DataVaultStep) Apply(config *model.DBConfig) {
 	config.EnableDataVault = s.enableDataVault
-
-	if s.enableDataVault {
-		config.DataVaultOwner = strings.TrimSpace(s.inputs[dvIdxOwner].Value())
-		config.DataVaultAccountManager = strings.TrimSpace(s.inputs[dvIdxAccountManager].Value())
+	config.EnableLabelSecurity = s.enableLabelSecurity
+
+	for _, idx := range s.visibleFields() {
+		switch idx {
+		case dvIdxOwner:
+			config.DataVaultOwner = strings.TrimSpace(s.inputs[idx].Value())
+		case dvIdxOwnerPassword:
+			config.DataVaultOwnerPassword = s.inputs[idx].Value()
+		case dvIdxAccountManager:
+			config.DataVaultAccountManager = strings.TrimSpace(s.inputs[idx].Value())
+		case dvIdxAccountManagerPassword:
+			config.DataVaultAccountManagerPassword = s.inputs[idx].Value()
+		}
 	}
 }
 
 // ShouldSkip returns whether this step should be skipped
 func (s *DataVaultStep) ShouldSkip(config *model.DBConfig) bool {
-	// Only show in advanced mode for create operation
-	return config.Operation != model.OperationCreate || config.CreationMode != model.CreationModeAdvanced
+	// Shown when configuring a database, and when creating one in advanced mode
+	switch config.Operation {
+	case model.OperationConfigure:
+		return false
+	case model.OperationCreate:
+		return config.CreationMode != model.CreationModeAdvanced
+	default:
+		return true
+	}
 }
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"dbca_tui/internal/hostprobe"
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -15,18 +17,70 @@ import (
 
 // DeleteStep handles database deletion configuration
 type DeleteStep struct {
-	config      *model.DBConfig
-	sidInput    textinput.Model
-	sysPassword textinput.Model
-	focusIndex  int
-	forceDelete bool
-	err         string
+	config         *model.DBConfig
+	list           ui.SelectList
+	databases      []hostprobe.OratabEntry // Databases registered in oratab
+	phase          int                     // 0=database list, 1=delete options
+	sidInput       textinput.Model
+	sysPassword    textinput.Model
+	walletLocation textinput.Model
+	walletAlias    textinput.Model
+	focusIndex     int // Index into visibleFields
+	useWallet      bool
+	forceDelete    bool
+	oratabErr      string // Why oratab could not be read in full
+	err            string
 }
 
+// Focus positions of the delete options
+const (
+	delPosSID = iota
+	delPosWallet
+	delPosPassword
+	delPosWalletLocation
+	delPosWalletAlias
+	delPosForce
+)
+
+// deleteOtherValue is the list value of the free text SID item; it is never a SID
+const deleteOtherValue = "*"
+
 // NewDeleteStep creates a new delete step
 func NewDeleteStep() *DeleteStep {
 	s := &DeleteStep{}
 
+	// Offer the databases of the local oratab; without one the SID is typed
+	entries, path, err := hostprobe.ReadOratab()
+	if err != nil {
+		s.oratabErr = fmt.Sprintf("Error reading oratab: %v", err)
+	}
+	var items []ui.SelectItem
+	for _, entry := range entries {
+		if !entry.IsDatabase() {
+			continue
+		}
+		s.databases = append(s.databases, entry)
+		description := "ORACLE_HOME: " + entry.OracleHome
+		if entry.AutoStart {
+			description += " (started at boot)"
+		}
+		items = append(items, ui.SelectItem{
+			Title:       entry.SID,
+			Description: description,
+			Value:       entry.SID,
+		})
+	}
+	other := "Enter the SID of a database not listed in " + path
+	if path == "" {
+		other = "Enter the SID of the database; no oratab file was found"
+	}
+	items = append(items, ui.SelectItem{
+		Title:       "Other database",
+		Description: other,
+		Value:       deleteOtherValue,
+	})
+	s.list = ui.NewSelectList(items)
+
 	s.sidInput = textinput.New()
 	s.sidInput.Placeholder = "orcl"
 	s.sidInput.CharLimit = 12
@@ -37,6 +91,8 @@ func NewDeleteStep() *DeleteStep {
 	s.sysPassword.EchoCharacter = '*'
 	s.sysPassword.CharLimit = 30
 
+	s.walletLocation, s.walletAlias = newWalletInputs()
+
 	return s
 }
 
@@ -46,32 +102,63 @@ func (s *DeleteStep) Init(config *model.DBConfig) tea.Cmd {
 	s.focusIndex = 0
 	s.err = ""
 	s.forceDelete = config.DeleteForce
+	s.useWallet = config.UseWallet
 
 	s.sidInput.SetValue(config.DeleteSID)
 	s.sysPassword.SetValue(config.SysPassword)
+	s.walletLocation.SetValue(config.WalletLocation)
+	s.walletAlias.SetValue(config.WalletAlias)
 
-	s.sidInput.Blur()
-	s.sysPassword.Blur()
-	s.sidInput.Focus()
+	s.blurAll()
+
+	// Start from the oratab list when there is one, on the database chosen before
+	s.list.Reset()
+	s.list.Cursor = 0
+	if len(s.databases) == 0 {
+		s.phase = 1
+		s.sidInput.Focus()
+		return textinput.Blink
+	}
+	s.phase = 0
+	if config.DeleteSID != "" {
+		s.list.Cursor = len(s.list.Items) - 1
+		for i, item := range s.list.Items {
+			if item.Value == config.DeleteSID {
+				s.list.Cursor = i
+				break
+			}
+		}
+	}
 
-	return textinput.Blink
+	return nil
 }
 
 // Update handles messages
 func (s *DeleteStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if s.phase == 0 {
+		return s.updateList(msg)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "esc":
+			if len(s.databases) > 0 {
+				s.phase = 0
+				s.err = ""
+				s.list.Reset()
+				s.blurAll()
+				return s, wizard.StepStay, nil
+			}
 			return s, wizard.StepBack, nil
 
 		case "tab", "down":
-			s.nextField()
-			return s, wizard.StepStay, nil
+			s.moveFocus(1)
+			return s, wizard.StepStay, textinput.Blink
 
 		case "shift+tab", "up":
-			s.prevField()
-			return s, wizard.StepStay, nil
+			s.moveFocus(-1)
+			return s, wizard.StepStay, textinput.Blink
 
 		case "enter":
 			if s.validate() {
@@ -80,74 +167,123 @@ func (s *DeleteStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cm
 			return s, wizard.StepStay, nil
 
 		case "f", "F":
-			if s.focusIndex == 2 {
+			if s.focused() == delPosForce {
 				s.forceDelete = !s.forceDelete
 			}
+
+		case "w", "W":
+			// Toggle the wallet when not in text input
+			if s.focused() == delPosWallet {
+				s.useWallet = !s.useWallet
+				return s, wizard.StepStay, nil
+			}
 		}
 	}
 
 	// Update the focused text input
-	var cmd tea.Cmd
-	switch s.focusIndex {
-	case 0:
-		s.sidInput, cmd = s.sidInput.Update(msg)
-	case 1:
-		s.sysPassword, cmd = s.sysPassword.Update(msg)
-	}
-
-	return s, wizard.StepStay, cmd
-}
-
-func (s *DeleteStep) nextField() {
-	switch s.focusIndex {
-	case 0:
-		s.sidInput.Blur()
-		s.focusIndex = 1
-		s.sysPassword.Focus()
-	case 1:
-		s.sysPassword.Blur()
-		s.focusIndex = 2
-	case 2:
-		s.focusIndex = 0
-		s.sidInput.Focus()
+	if input := s.input(s.focused()); input != nil {
+		var cmd tea.Cmd
+		*input, cmd = input.Update(msg)
+		return s, wizard.StepStay, cmd
 	}
+
+	return s, wizard.StepStay, nil
 }
 
-func (s *DeleteStep) prevField() {
-	switch s.focusIndex {
-	case 0:
-		s.sidInput.Blur()
-		s.focusIndex = 2
-	case 1:
-		s.sysPassword.Blur()
-		s.focusIndex = 0
-		s.sidInput.Focus()
-	case 2:
-		s.focusIndex = 1
-		s.sysPassword.Focus()
+// updateList handles the choice of a database from oratab
+func (s *DeleteStep) updateList(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return s, wizard.StepStay, nil
+	}
+
+	switch key.String() {
+	case "esc":
+		return s, wizard.StepBack, nil
+	case "enter", " ":
+		s.list.Update(msg)
+		s.phase = 1
+		s.err = ""
+		if sid := s.list.GetSelectedValue(); sid != deleteOtherValue {
+			// The SID is known, continue with the password or wallet
+			s.sidInput.SetValue(sid)
+			s.focusIndex = 2
+		} else {
+			if s.oracleHome(s.sidInput.Value()) != "" {
+				s.sidInput.SetValue("")
+			}
+			s.focusIndex = 0
+		}
+		s.input(s.focused()).Focus()
+		return s, wizard.StepStay, textinput.Blink
+	default:
+		s.list.Update(msg)
 	}
+
+	return s, wizard.StepStay, nil
 }
 
-func (s *DeleteStep) validate() bool {
-	s.err = ""
+// oracleHome returns the home oratab registers for a SID, or "" when it is not listed
+func (s *DeleteStep) oracleHome(sid string) string {
+	sid = strings.TrimSpace(sid)
+	for _, entry := range s.databases {
+		if entry.SID == sid {
+			return entry.OracleHome
+		}
+	}
+	return ""
+}
 
-	sid := strings.TrimSpace(s.sidInput.Value())
-	if sid == "" {
-		s.err = "Database SID is required"
-		return false
+// visibleFields returns the focus positions shown for the current settings
+func (s *DeleteStep) visibleFields() []int {
+	if s.useWallet {
+		return []int{delPosSID, delPosWallet, delPosWalletLocation, delPosWalletAlias, delPosForce}
 	}
-	if len(sid) > 12 {
-		s.err = "SID must be 12 characters or less"
-		return false
+	return []int{delPosSID, delPosWallet, delPosPassword, delPosForce}
+}
+
+// focused returns the focus position of the focused field
+func (s *DeleteStep) focused() int {
+	return s.visibleFields()[s.focusIndex]
+}
+
+// input returns the text input at a focus position, or nil for a toggle
+func (s *DeleteStep) input(position int) *textinput.Model {
+	switch position {
+	case delPosSID:
+		return &s.sidInput
+	case delPosPassword:
+		return &s.sysPassword
+	case delPosWalletLocation:
+		return &s.walletLocation
+	case delPosWalletAlias:
+		return &s.walletAlias
+	default:
+		return nil
 	}
+}
 
-	pwd := s.sysPassword.Value()
-	if pwd == "" {
-		s.err = "SYS password is required for deletion"
-		return false
+func (s *DeleteStep) moveFocus(delta int) {
+	fields := s.visibleFields()
+	s.blurAll()
+	s.focusIndex = (s.focusIndex + delta + len(fields)) % len(fields)
+	if input := s.input(s.focused()); input != nil {
+		input.Focus()
 	}
+}
 
-	return true
+func (s *DeleteStep) blurAll() {
+	s.sidInput.Blur()
+	s.sysPassword.Blur()
+	s.walletLocation.Blur()
+	s.walletAlias.Blur()
+}
+
+func (s *DeleteStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.Delete)
+	return s.err == ""
 }
 
 // View renders the step
@@ -162,19 +298,50 @@ func (s *DeleteStep) View() string {
 		Bold(true)
 	b.WriteString(warningStyle.Render("WARNING: This will generate a command to permanently delete the database!") + "\n\n")
 
+	if s.oratabErr != "" {
+		b.WriteString(ui.WarningStyle.Render(s.oratabErr) + "\n\n")
+	}
+
+	if s.phase == 0 {
+		b.WriteString(ui.LabelStyle.Render("Select the database to delete:") + "\n\n")
+		b.WriteString(s.list.View())
+		return b.String()
+	}
+
 	// SID input
-	b.WriteString(s.renderField("Database SID to delete", s.sidInput, 0) + "\n")
+	b.WriteString(s.renderField("Database SID to delete", s.sidInput, delPosSID))
+	if home := s.oracleHome(s.sidInput.Value()); home != "" {
+		b.WriteString(ui.SubtitleStyle.Render("    ORACLE_HOME: "+home) + "\n")
+	}
+	b.WriteString("\n")
 
-	// SYS Password
-	b.WriteString(s.renderField("SYS Password", s.sysPassword, 1) + "\n")
+	// Wallet toggle
+	checkbox := ui.UncheckedStyle.String()
+	if s.useWallet {
+		checkbox = ui.CheckedStyle.String()
+	}
+	walletStyle := ui.NormalItemStyle
+	if s.focused() == delPosWallet {
+		walletStyle = ui.SelectedItemStyle
+	}
+	b.WriteString(fmt.Sprintf("%s %s\n", checkbox, walletStyle.Render("Use wallet for SYS credentials")))
+	b.WriteString(ui.SubtitleStyle.Render("    Press 'w' to toggle") + "\n\n")
+
+	// SYS Password, or the wallet holding it
+	if s.useWallet {
+		b.WriteString(s.renderField("Wallet Location", s.walletLocation, delPosWalletLocation) + "\n")
+		b.WriteString(s.renderField("Wallet Alias (connect string of the credential)", s.walletAlias, delPosWalletAlias) + "\n")
+	} else {
+		b.WriteString(s.renderField("SYS Password", s.sysPassword, delPosPassword) + "\n")
+	}
 
 	// Force delete toggle
-	checkbox := ui.UncheckedStyle.String()
+	checkbox = ui.UncheckedStyle.String()
 	if s.forceDelete {
 		checkbox = ui.CheckedStyle.String()
 	}
 	forceStyle := ui.NormalItemStyle
-	if s.focusIndex == 2 {
+	if s.focused() == delPosForce {
 		forceStyle = ui.SelectedItemStyle
 	}
 	b.WriteString(fmt.Sprintf("\n%s %s\n", checkbox, forceStyle.Render("Force delete (abort running database)")))
@@ -184,16 +351,20 @@ func (s *DeleteStep) View() string {
 		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
 	}
 
-	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+	if len(s.databases) > 0 {
+		b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue, Esc to choose another database"))
+	} else {
+		b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+	}
 
 	return b.String()
 }
 
-func (s *DeleteStep) renderField(label string, input textinput.Model, index int) string {
+func (s *DeleteStep) renderField(label string, input textinput.Model, position int) string {
 	labelStyle := ui.LabelStyle
 	inputStyle := ui.InputStyle
 
-	if s.focusIndex == index {
+	if s.focused() == position {
 		inputStyle = ui.FocusedInputStyle
 	}
 
@@ -212,6 +383,9 @@ func (s *DeleteStep) Title() string {
 func (s *DeleteStep) Apply(config *model.DBConfig) {
 	config.DeleteSID = strings.TrimSpace(s.sidInput.Value())
 	config.SysPassword = s.sysPassword.Value()
+	config.UseWallet = s.useWallet
+	config.WalletLocation = strings.TrimSpace(s.walletLocation.Value())
+	config.WalletAlias = strings.TrimSpace(s.walletAlias.Value())
 	config.DeleteForce = s.forceDelete
 }
 
@@ -0,0 +1,169 @@
+package steps
+
+import (
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DeletePDBStep handles the delete pluggable database configuration
+type DeletePDBStep struct {
+	config     *model.DBConfig
+	inputs     []textinput.Model
+	focusIndex int
+	err        string
+}
+
+const (
+	dpIdxCDB = iota
+	dpIdxPDB
+	dpIdxSysPassword
+)
+
+// NewDeletePDBStep creates a new delete PDB step
+func NewDeletePDBStep() *DeletePDBStep {
+	s := &DeletePDBStep{
+		inputs: make([]textinput.Model, 3),
+	}
+
+	s.inputs[dpIdxCDB] = textinput.New()
+	s.inputs[dpIdxCDB].Placeholder = "orcl"
+	s.inputs[dpIdxCDB].CharLimit = 12
+
+	s.inputs[dpIdxPDB] = textinput.New()
+	s.inputs[dpIdxPDB].Placeholder = "salespdb"
+	s.inputs[dpIdxPDB].CharLimit = 30
+
+	s.inputs[dpIdxSysPassword] = textinput.New()
+	s.inputs[dpIdxSysPassword].Placeholder = "Empty for OS authentication"
+	s.inputs[dpIdxSysPassword].EchoMode = textinput.EchoPassword
+	s.inputs[dpIdxSysPassword].EchoCharacter = '*'
+	s.inputs[dpIdxSysPassword].CharLimit = 30
+
+	return s
+}
+
+// Init initializes the step
+func (s *DeletePDBStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.focusIndex = 0
+	s.err = ""
+
+	s.inputs[dpIdxCDB].SetValue(config.SourceCDB)
+	s.inputs[dpIdxPDB].SetValue(config.TargetPDB)
+	s.inputs[dpIdxSysPassword].SetValue(config.SysPassword)
+
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+	s.inputs[0].Focus()
+
+	return textinput.Blink
+}
+
+// Update handles messages
+func (s *DeletePDBStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return s, wizard.StepBack, nil
+
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+		}
+	}
+
+	// Update the focused text input
+	var cmd tea.Cmd
+	s.inputs[s.focusIndex], cmd = s.inputs[s.focusIndex].Update(msg)
+	return s, wizard.StepStay, cmd
+}
+
+func (s *DeletePDBStep) moveFocus(delta int) {
+	s.inputs[s.focusIndex].Blur()
+	s.focusIndex = (s.focusIndex + delta + len(s.inputs)) % len(s.inputs)
+	s.inputs[s.focusIndex].Focus()
+}
+
+func (s *DeletePDBStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.DeletePDB)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *DeletePDBStep) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.SubtitleStyle.Render("Configure pluggable database deletion:") + "\n\n")
+
+	// Warning
+	warningStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FF5555")).
+		Bold(true)
+	b.WriteString(warningStyle.Render("WARNING: This will generate a command to drop the PDB including its datafiles!") + "\n\n")
+
+	b.WriteString(s.renderField("Container Database SID", s.inputs[dpIdxCDB], dpIdxCDB) + "\n")
+	b.WriteString(s.renderField("PDB to delete", s.inputs[dpIdxPDB], dpIdxPDB) + "\n")
+	b.WriteString(s.renderField("SYS Password (optional)", s.inputs[dpIdxSysPassword], dpIdxSysPassword) + "\n")
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+func (s *DeletePDBStep) renderField(label string, input textinput.Model, index int) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if s.focusIndex == index {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *DeletePDBStep) Title() string {
+	return "Delete Pluggable Database"
+}
+
+// Apply applies the step's changes to the config
+func (s *DeletePDBStep) Apply(config *model.DBConfig) {
+	config.SourceCDB = strings.TrimSpace(s.inputs[dpIdxCDB].Value())
+	config.TargetPDB = strings.TrimSpace(s.inputs[dpIdxPDB].Value())
+	config.SysPassword = s.inputs[dpIdxSysPassword].Value()
+	config.UseOSAuthentication = config.SysPassword == ""
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *DeletePDBStep) ShouldSkip(config *model.DBConfig) bool {
+	return config.Operation != model.OperationDeletePDB
+}
@@ -0,0 +1,254 @@
+package steps
+
+import (
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DuplicateStep handles the primary database and the name of its duplicate or standby
+type DuplicateStep struct {
+	config          *model.DBConfig
+	inputs          []textinput.Model
+	focusIndex      int // Index into visibleFields, then the standby toggle
+	createAsStandby bool
+	err             string
+}
+
+const (
+	dupIdxPrimary = iota
+	dupIdxPrimaryDBName
+	dupIdxSysPassword
+	dupIdxGlobalName
+	dupIdxSID
+	dupIdxUniqueName
+)
+
+// NewDuplicateStep creates a new duplicate database step
+func NewDuplicateStep() *DuplicateStep {
+	s := &DuplicateStep{
+		inputs: make([]textinput.Model, 6),
+	}
+
+	placeholders := []string{
+		dupIdxPrimary:       "primary-host:1521/orcl",
+		dupIdxPrimaryDBName: "orcl",
+		dupIdxSysPassword:   "Password",
+		dupIdxGlobalName:    "orcl.example.com",
+		dupIdxSID:           "orcl",
+		dupIdxUniqueName:    "orcl_stby",
+	}
+	limits := []int{
+		dupIdxPrimary:       256,
+		dupIdxPrimaryDBName: 8,
+		dupIdxSysPassword:   30,
+		dupIdxGlobalName:    128,
+		dupIdxSID:           12,
+		dupIdxUniqueName:    30,
+	}
+	for i := range s.inputs {
+		s.inputs[i] = textinput.New()
+		s.inputs[i].Placeholder = placeholders[i]
+		s.inputs[i].CharLimit = limits[i]
+	}
+	s.inputs[dupIdxSysPassword].EchoMode = textinput.EchoPassword
+	s.inputs[dupIdxSysPassword].EchoCharacter = '*'
+
+	return s
+}
+
+// Init initializes the step
+func (s *DuplicateStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.focusIndex = 0
+	s.err = ""
+	s.createAsStandby = config.CreateAsStandby
+
+	s.inputs[dupIdxPrimary].SetValue(config.PrimaryConnectString)
+	s.inputs[dupIdxPrimaryDBName].SetValue(config.PrimaryDBName)
+	s.inputs[dupIdxSysPassword].SetValue(config.SysPassword)
+	s.inputs[dupIdxGlobalName].SetValue(config.GlobalDBName)
+	s.inputs[dupIdxSID].SetValue(config.SID)
+	s.inputs[dupIdxUniqueName].SetValue(config.StandbyUniqueName)
+
+	s.blurAll()
+	s.inputs[s.visibleFields()[0]].Focus()
+
+	return textinput.Blink
+}
+
+// Update handles messages
+func (s *DuplicateStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	fields := s.visibleFields()
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return s, wizard.StepBack, nil
+
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, textinput.Blink
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, textinput.Blink
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+
+		case "s", "S":
+			// Toggle the standby when not in text input
+			if s.focusIndex == len(fields) {
+				s.createAsStandby = !s.createAsStandby
+				s.focusIndex = len(s.visibleFields())
+				return s, wizard.StepStay, nil
+			}
+		}
+	}
+
+	// Update the focused text input
+	if s.focusIndex < len(fields) {
+		var cmd tea.Cmd
+		idx := fields[s.focusIndex]
+		s.inputs[idx], cmd = s.inputs[idx].Update(msg)
+		return s, wizard.StepStay, cmd
+	}
+
+	return s, wizard.StepStay, nil
+}
+
+// visibleFields returns the inputs shown for the current settings
+func (s *DuplicateStep) visibleFields() []int {
+	fields := []int{dupIdxPrimary, dupIdxPrimaryDBName, dupIdxSysPassword, dupIdxGlobalName, dupIdxSID}
+	if s.createAsStandby {
+		fields = append(fields, dupIdxUniqueName)
+	}
+	return fields
+}
+
+// moveFocus cycles through the visible inputs and the standby toggle
+func (s *DuplicateStep) moveFocus(delta int) {
+	fields := s.visibleFields()
+	positions := len(fields) + 1
+
+	s.blurAll()
+	s.focusIndex = (s.focusIndex + delta + positions) % positions
+	if s.focusIndex < len(fields) {
+		s.inputs[fields[s.focusIndex]].Focus()
+	}
+}
+
+func (s *DuplicateStep) blurAll() {
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+}
+
+func (s *DuplicateStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.DuplicateSource)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *DuplicateStep) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.SubtitleStyle.Render("Duplicate a primary database:") + "\n\n")
+
+	fields := s.visibleFields()
+	for i, idx := range fields {
+		b.WriteString(s.renderField(s.fieldLabel(idx), s.inputs[idx], i) + "\n")
+	}
+
+	// Standby toggle
+	checkbox := ui.UncheckedStyle.String()
+	if s.createAsStandby {
+		checkbox = ui.CheckedStyle.String()
+	}
+	style := ui.NormalItemStyle
+	if s.focusIndex == len(fields) {
+		style = ui.SelectedItemStyle
+	}
+	b.WriteString("\n" + checkbox + " " + style.Render("Create as physical standby (Data Guard)") + "\n")
+	b.WriteString(ui.SubtitleStyle.Render("    Press 's' to toggle") + "\n")
+	if s.createAsStandby {
+		b.WriteString(ui.SubtitleStyle.Render("    The standby keeps the primary DB_NAME and gets its own DB_UNIQUE_NAME") + "\n")
+	}
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+// fieldLabel returns the label of an input
+func (s *DuplicateStep) fieldLabel(idx int) string {
+	switch idx {
+	case dupIdxPrimary:
+		return "Primary Connect String (host:port/service)"
+	case dupIdxPrimaryDBName:
+		return "Primary DB_NAME"
+	case dupIdxSysPassword:
+		return "SYS Password of the Primary"
+	case dupIdxGlobalName:
+		return "Global Database Name"
+	case dupIdxSID:
+		return "SID"
+	default:
+		return "Standby DB_UNIQUE_NAME"
+	}
+}
+
+func (s *DuplicateStep) renderField(label string, input textinput.Model, fieldIndex int) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if s.focusIndex == fieldIndex {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *DuplicateStep) Title() string {
+	return "Duplicate Database"
+}
+
+// Apply applies the step's changes to the config
+func (s *DuplicateStep) Apply(config *model.DBConfig) {
+	config.PrimaryConnectString = strings.TrimSpace(s.inputs[dupIdxPrimary].Value())
+	config.PrimaryDBName = strings.TrimSpace(s.inputs[dupIdxPrimaryDBName].Value())
+	config.SysPassword = s.inputs[dupIdxSysPassword].Value()
+	config.GlobalDBName = strings.TrimSpace(s.inputs[dupIdxGlobalName].Value())
+	config.SID = strings.TrimSpace(s.inputs[dupIdxSID].Value())
+	config.CreateAsStandby = s.createAsStandby
+	if s.createAsStandby {
+		config.StandbyUniqueName = strings.TrimSpace(s.inputs[dupIdxUniqueName].Value())
+	}
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *DuplicateStep) ShouldSkip(config *model.DBConfig) bool {
+	return config.Operation != model.OperationDuplicate
+}
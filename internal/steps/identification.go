@@ -7,6 +7,7 @@ import (
 
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -20,6 +21,7 @@ type IdentificationStep struct {
 	inputs       []textinput.Model
 	focusIndex   int
 	createCDB    bool
+	localUndo    bool
 	err          string
 }
 
@@ -71,6 +73,12 @@ func (s *IdentificationStep) Init(config *model.DBConfig) tea.Cmd {
 	s.inputs[idxNumPDBs].SetValue(strconv.Itoa(config.NumberOfPDBs))
 	s.inputs[idxPDBName].SetValue(config.PDBName)
 	s.createCDB = config.CreateAsContainerDB
+	s.localUndo = config.UseLocalUndoForPDBs
+
+	// 21c and later can only create container databases
+	if !config.TargetVersion.SupportsNonCDB() {
+		s.createCDB = true
+	}
 
 	// Focus first input
 	for i := range s.inputs {
@@ -106,7 +114,17 @@ func (s *IdentificationStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult
 		case "c", "C":
 			// Toggle CDB mode when not in text input
 			if s.focusIndex >= len(s.inputs) {
-				s.createCDB = !s.createCDB
+				if s.createCDB && !s.config.TargetVersion.SupportsNonCDB() {
+					s.err = fmt.Sprintf("Oracle %s only supports container databases", s.config.TargetVersion)
+				} else {
+					s.createCDB = !s.createCDB
+				}
+			}
+
+		case "u", "U":
+			// Toggle local undo when not in text input
+			if s.focusIndex >= len(s.inputs) && s.createCDB {
+				s.localUndo = !s.localUndo
 			}
 		}
 	}
@@ -164,42 +182,16 @@ func (s *IdentificationStep) prevField() {
 func (s *IdentificationStep) validate() bool {
 	s.err = ""
 
-	// Validate Global DB Name
-	globalName := strings.TrimSpace(s.inputs[idxGlobalName].Value())
-	if globalName == "" {
-		s.err = "Global Database Name is required"
-		return false
-	}
-
-	// Validate SID
-	sid := strings.TrimSpace(s.inputs[idxSID].Value())
-	if sid == "" {
-		s.err = "SID is required"
-		return false
-	}
-	if len(sid) > 12 {
-		s.err = "SID must be 12 characters or less"
-		return false
-	}
-
-	// Validate PDB settings if CDB
+	// The number of PDBs must parse before the config-level rules can run
 	if s.createCDB {
-		numPDBs, err := strconv.Atoi(strings.TrimSpace(s.inputs[idxNumPDBs].Value()))
-		if err != nil || numPDBs < 0 || numPDBs > 252 {
+		if _, err := strconv.Atoi(strings.TrimSpace(s.inputs[idxNumPDBs].Value())); err != nil {
 			s.err = "Number of PDBs must be between 0 and 252"
 			return false
 		}
-
-		if numPDBs > 0 {
-			pdbName := strings.TrimSpace(s.inputs[idxPDBName].Value())
-			if pdbName == "" {
-				s.err = "PDB Name/Prefix is required when creating PDBs"
-				return false
-			}
-		}
 	}
 
-	return true
+	s.err = validateStep(s, s.config, validation.Identification)
+	return s.err == ""
 }
 
 // View renders the step
@@ -211,6 +203,13 @@ func (s *IdentificationStep) View() string {
 	// Global Database Name
 	b.WriteString(s.renderField("Global Database Name", s.inputs[idxGlobalName], 0) + "\n")
 
+	// Show how the global name splits into DB_NAME and DB_DOMAIN
+	dbName, dbDomain := validation.SplitGlobalName(s.inputs[idxGlobalName].Value())
+	if dbDomain == "" {
+		dbDomain = "(none)"
+	}
+	b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("  DB_NAME: %s   DB_DOMAIN: %s", dbName, dbDomain)) + "\n")
+
 	// SID
 	b.WriteString(s.renderField("Oracle SID", s.inputs[idxSID], 1) + "\n")
 
@@ -224,12 +223,23 @@ func (s *IdentificationStep) View() string {
 		cdbStyle = ui.SelectedItemStyle
 	}
 	b.WriteString(fmt.Sprintf("\n%s %s\n", checkbox, cdbStyle.Render("Create as Container Database (CDB)")) + "\n")
-	b.WriteString(ui.SubtitleStyle.Render("    Press 'c' to toggle") + "\n\n")
+	if s.config.TargetVersion.SupportsNonCDB() {
+		b.WriteString(ui.SubtitleStyle.Render("    Press 'c' to toggle") + "\n\n")
+	} else {
+		b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("    Required for Oracle %s", s.config.TargetVersion)) + "\n\n")
+	}
 
 	// PDB settings (only if CDB enabled)
 	if s.createCDB {
 		b.WriteString(s.renderField("Number of PDBs", s.inputs[idxNumPDBs], 2) + "\n")
 		b.WriteString(s.renderField("PDB Name/Prefix", s.inputs[idxPDBName], 3) + "\n")
+
+		undoBox := ui.UncheckedStyle.String()
+		if s.localUndo {
+			undoBox = ui.CheckedStyle.String()
+		}
+		b.WriteString(fmt.Sprintf("\n%s %s\n", undoBox, ui.NormalItemStyle.Render("Use local undo for PDBs")))
+		b.WriteString(ui.SubtitleStyle.Render("    Press 'u' to toggle") + "\n")
 	}
 
 	// Error message
@@ -272,6 +282,7 @@ func (s *IdentificationStep) Apply(config *model.DBConfig) {
 		config.NumberOfPDBs = numPDBs
 		config.PDBName = strings.TrimSpace(s.inputs[idxPDBName].Value())
 		config.PDBPrefix = config.PDBName
+		config.UseLocalUndoForPDBs = s.localUndo
 	} else {
 		config.NumberOfPDBs = 0
 		config.PDBName = ""
@@ -0,0 +1,331 @@
+package steps
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// InitParamsStep handles custom initialization parameters (Advanced mode)
+type InitParamsStep struct {
+	config     *model.DBConfig
+	params     map[string]string
+	names      []string // Sorted parameter names shown in the table
+	cursor     int
+	inputs     []textinput.Model
+	phase      int    // 0=parameter table, 1=add/edit parameter
+	editing    string // Name of the parameter being edited, empty when adding
+	focusIndex int
+	err        string
+}
+
+const (
+	ipIdxName = iota
+	ipIdxValue
+)
+
+// NewInitParamsStep creates a new init parameters step
+func NewInitParamsStep() *InitParamsStep {
+	s := &InitParamsStep{
+		inputs: make([]textinput.Model, 2),
+	}
+
+	suggestions := make([]string, len(model.KnownInitParams))
+	for i, p := range model.KnownInitParams {
+		suggestions[i] = p.Name
+	}
+
+	// Parameter name with autocomplete for well-known parameters
+	s.inputs[ipIdxName] = textinput.New()
+	s.inputs[ipIdxName].Placeholder = "processes"
+	s.inputs[ipIdxName].CharLimit = 64
+	s.inputs[ipIdxName].ShowSuggestions = true
+	s.inputs[ipIdxName].SetSuggestions(suggestions)
+
+	// Parameter value
+	s.inputs[ipIdxValue] = textinput.New()
+	s.inputs[ipIdxValue].Placeholder = "300"
+	s.inputs[ipIdxValue].CharLimit = 512
+
+	return s
+}
+
+// Init initializes the step
+func (s *InitParamsStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.phase = 0
+	s.cursor = 0
+	s.err = ""
+
+	s.params = make(map[string]string, len(config.InitParams))
+	for name, value := range config.InitParams {
+		s.params[name] = value
+	}
+	s.refreshNames()
+
+	return nil
+}
+
+// Update handles messages
+func (s *InitParamsStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "esc" {
+		if s.phase > 0 {
+			s.phase = 0
+			s.err = ""
+			return s, wizard.StepStay, nil
+		}
+		return s, wizard.StepBack, nil
+	}
+
+	if s.phase == 0 {
+		return s.updateTable(msg)
+	}
+	return s.updateEditor(msg)
+}
+
+func (s *InitParamsStep) updateTable(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if s.cursor > 0 {
+				s.cursor--
+			}
+		case "down", "j":
+			if s.cursor < len(s.names)-1 {
+				s.cursor++
+			}
+		case "a", "A":
+			return s, wizard.StepStay, s.startEdit("")
+		case "e", "E":
+			if len(s.names) > 0 {
+				return s, wizard.StepStay, s.startEdit(s.names[s.cursor])
+			}
+		case "d", "D", "delete":
+			if len(s.names) > 0 {
+				delete(s.params, s.names[s.cursor])
+				s.refreshNames()
+				if s.cursor >= len(s.names) && s.cursor > 0 {
+					s.cursor--
+				}
+				s.err = ""
+			}
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+		}
+	}
+	return s, wizard.StepStay, nil
+}
+
+func (s *InitParamsStep) updateEditor(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "tab":
+			// Tab completes the parameter name first, then moves on
+			if s.focusIndex == ipIdxName && s.canComplete() {
+				break
+			}
+			s.switchField()
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up", "down":
+			s.switchField()
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			s.saveParam()
+			return s, wizard.StepStay, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	s.inputs[s.focusIndex], cmd = s.inputs[s.focusIndex].Update(msg)
+	return s, wizard.StepStay, cmd
+}
+
+// startEdit opens the editor for an existing parameter, or a new one if name is empty
+func (s *InitParamsStep) startEdit(name string) tea.Cmd {
+	s.phase = 1
+	s.editing = name
+	s.err = ""
+	s.inputs[ipIdxName].SetValue(name)
+	s.inputs[ipIdxValue].SetValue(s.params[name])
+
+	s.focusIndex = ipIdxName
+	if name != "" {
+		s.focusIndex = ipIdxValue
+	}
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+	s.inputs[s.focusIndex].Focus()
+
+	return textinput.Blink
+}
+
+// saveParam validates the editor fields and stores the parameter
+func (s *InitParamsStep) saveParam() {
+	name := strings.ToLower(strings.TrimSpace(s.inputs[ipIdxName].Value()))
+	value := strings.TrimSpace(s.inputs[ipIdxValue].Value())
+
+	if msg := validation.CheckInitParam(name, value); msg != "" {
+		s.err = msg
+		return
+	}
+	if _, exists := s.params[name]; exists && name != s.editing {
+		s.err = fmt.Sprintf("%s is already set; select it and press 'e' to edit", name)
+		return
+	}
+
+	if s.editing != "" {
+		delete(s.params, s.editing)
+	}
+	s.params[name] = value
+	s.refreshNames()
+	for i, n := range s.names {
+		if n == name {
+			s.cursor = i
+		}
+	}
+
+	s.phase = 0
+	s.err = ""
+}
+
+func (s *InitParamsStep) switchField() {
+	s.inputs[s.focusIndex].Blur()
+	s.focusIndex = (s.focusIndex + 1) % len(s.inputs)
+	s.inputs[s.focusIndex].Focus()
+}
+
+// canComplete returns true if Tab would change the name to a suggestion
+func (s *InitParamsStep) canComplete() bool {
+	input := s.inputs[ipIdxName]
+	return len(input.MatchedSuggestions()) > 0 && input.CurrentSuggestion() != input.Value()
+}
+
+func (s *InitParamsStep) refreshNames() {
+	s.names = s.names[:0]
+	for name := range s.params {
+		s.names = append(s.names, name)
+	}
+	sort.Strings(s.names)
+}
+
+func (s *InitParamsStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.InitParameters)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *InitParamsStep) View() string {
+	if s.phase == 1 {
+		return s.viewEditor()
+	}
+
+	var b strings.Builder
+
+	b.WriteString(ui.SubtitleStyle.Render("Custom initialization parameters:") + "\n\n")
+
+	if len(s.names) == 0 {
+		b.WriteString(ui.NormalItemStyle.Render("  No custom parameters; the template defaults apply") + "\n")
+	}
+	for i, name := range s.names {
+		cursor := "  "
+		style := ui.NormalItemStyle
+		if i == s.cursor {
+			cursor = ui.CursorStyle.Render("> ")
+			style = ui.SelectedItemStyle
+		}
+		b.WriteString(cursor + style.Render(fmt.Sprintf("%-28s = %s", name, s.params[name])) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press 'a' to add, 'e' to edit, 'd' to delete") + "\n")
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+func (s *InitParamsStep) viewEditor() string {
+	var b strings.Builder
+
+	title := "Add parameter:"
+	if s.editing != "" {
+		title = "Edit parameter:"
+	}
+	b.WriteString(ui.SubtitleStyle.Render(title) + "\n\n")
+
+	b.WriteString(s.renderField("Parameter Name", s.inputs[ipIdxName], ipIdxName) + "\n")
+
+	// Describe the typed (or suggested) parameter when it is well known
+	name := strings.ToLower(strings.TrimSpace(s.inputs[ipIdxName].Value()))
+	if s.focusIndex == ipIdxName && s.canComplete() {
+		name = s.inputs[ipIdxName].CurrentSuggestion()
+	}
+	if info, ok := model.LookupInitParam(name); ok {
+		b.WriteString(ui.SubtitleStyle.Render("  "+info.Description) + "\n")
+	}
+
+	b.WriteString(s.renderField("Value", s.inputs[ipIdxValue], ipIdxValue) + "\n")
+	b.WriteString(ui.SubtitleStyle.Render("    Tab completes a known name, ctrl+n/ctrl+p cycles suggestions") + "\n")
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to save, Esc to cancel"))
+
+	return b.String()
+}
+
+func (s *InitParamsStep) renderField(label string, input textinput.Model, index int) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if s.focusIndex == index {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *InitParamsStep) Title() string {
+	return "Initialization Parameters"
+}
+
+// Apply applies the step's changes to the config
+func (s *InitParamsStep) Apply(config *model.DBConfig) {
+	config.InitParams = make(map[string]string, len(s.params))
+	for name, value := range s.params {
+		config.InitParams[name] = value
+	}
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *InitParamsStep) ShouldSkip(config *model.DBConfig) bool {
+	// Skip for delete operation or in typical mode
+	return config.Operation != model.OperationCreate || config.CreationMode == model.CreationModeTypical
+}
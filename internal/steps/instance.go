@@ -0,0 +1,254 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// InstanceStep handles adding an instance to, or deleting one from, a RAC database
+type InstanceStep struct {
+	config     *model.DBConfig
+	inputs     []textinput.Model
+	focusIndex int // Index into positions
+	useWallet  bool
+	err        string
+}
+
+const (
+	instIdxGlobalName = iota
+	instIdxNode
+	instIdxInstance
+	instIdxNodeList
+	instIdxSysPassword
+	instIdxWalletLocation
+	instIdxWalletAlias
+)
+
+// instPosWallet is the focus position of the wallet toggle after the inputs
+const instPosWallet = instIdxWalletAlias + 1
+
+// NewInstanceStep creates a new instance step
+func NewInstanceStep() *InstanceStep {
+	s := &InstanceStep{
+		inputs: make([]textinput.Model, 7),
+	}
+
+	placeholders := []string{
+		instIdxGlobalName:  "orcl.example.com",
+		instIdxNode:        "racnode3",
+		instIdxInstance:    "orcl3",
+		instIdxNodeList:    "racnode1,racnode2,racnode3 (optional)",
+		instIdxSysPassword: "Password",
+	}
+	limits := []int{
+		instIdxGlobalName:  128,
+		instIdxNode:        64,
+		instIdxInstance:    12,
+		instIdxNodeList:    512,
+		instIdxSysPassword: 30,
+	}
+	for i := range placeholders {
+		s.inputs[i] = textinput.New()
+		s.inputs[i].Placeholder = placeholders[i]
+		s.inputs[i].CharLimit = limits[i]
+	}
+	s.inputs[instIdxSysPassword].EchoMode = textinput.EchoPassword
+	s.inputs[instIdxSysPassword].EchoCharacter = '*'
+	s.inputs[instIdxWalletLocation], s.inputs[instIdxWalletAlias] = newWalletInputs()
+
+	return s
+}
+
+// Init initializes the step
+func (s *InstanceStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.focusIndex = 0
+	s.err = ""
+	s.useWallet = config.UseWallet
+
+	s.inputs[instIdxGlobalName].SetValue(config.GlobalDBName)
+	s.inputs[instIdxNode].SetValue(config.InstanceNode)
+	s.inputs[instIdxInstance].SetValue(config.InstanceName)
+	s.inputs[instIdxNodeList].SetValue(config.NodeList)
+	s.inputs[instIdxSysPassword].SetValue(config.SysPassword)
+	s.inputs[instIdxWalletLocation].SetValue(config.WalletLocation)
+	s.inputs[instIdxWalletAlias].SetValue(config.WalletAlias)
+
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+	s.inputs[0].Focus()
+
+	return textinput.Blink
+}
+
+// Update handles messages
+func (s *InstanceStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return s, wizard.StepBack, nil
+
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+
+		case "w", "W":
+			if s.focused() == instPosWallet {
+				s.useWallet = !s.useWallet
+				return s, wizard.StepStay, nil
+			}
+		}
+	}
+
+	// Update the focused text input
+	if idx := s.focused(); idx < len(s.inputs) {
+		var cmd tea.Cmd
+		s.inputs[idx], cmd = s.inputs[idx].Update(msg)
+		return s, wizard.StepStay, cmd
+	}
+
+	return s, wizard.StepStay, nil
+}
+
+// positions returns the focus positions in display order; the wallet
+// replaces the SYS password
+func (s *InstanceStep) positions() []int {
+	positions := []int{instIdxGlobalName, instIdxNode, instIdxInstance, instIdxNodeList, instPosWallet}
+	if s.useWallet {
+		return append(positions, instIdxWalletLocation, instIdxWalletAlias)
+	}
+	return append(positions, instIdxSysPassword)
+}
+
+// focused returns the focus position of the focused field
+func (s *InstanceStep) focused() int {
+	return s.positions()[s.focusIndex]
+}
+
+func (s *InstanceStep) moveFocus(delta int) {
+	positions := s.positions()
+	if idx := s.focused(); idx < len(s.inputs) {
+		s.inputs[idx].Blur()
+	}
+	s.focusIndex = (s.focusIndex + delta + len(positions)) % len(positions)
+	if idx := s.focused(); idx < len(s.inputs) {
+		s.inputs[idx].Focus()
+	}
+}
+
+func (s *InstanceStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.Instance)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *InstanceStep) View() string {
+	var b strings.Builder
+
+	instanceLabel := "Instance Name (optional, DBCA picks the next one)"
+	if s.config.Operation == model.OperationDeleteInstance {
+		b.WriteString(ui.SubtitleStyle.Render("Configure instance deletion:") + "\n\n")
+
+		warningStyle := lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#FF5555")).
+			Bold(true)
+		b.WriteString(warningStyle.Render("WARNING: This will generate a command to remove the instance and its redo thread and undo tablespace!") + "\n\n")
+
+		instanceLabel = "Instance Name"
+	} else {
+		b.WriteString(ui.SubtitleStyle.Render("Add an instance to a RAC database:") + "\n\n")
+	}
+
+	b.WriteString(s.renderField("Global Database Name", s.inputs[instIdxGlobalName], instIdxGlobalName) + "\n")
+	b.WriteString(s.renderField("Node Name", s.inputs[instIdxNode], instIdxNode) + "\n")
+	b.WriteString(s.renderField(instanceLabel, s.inputs[instIdxInstance], instIdxInstance) + "\n")
+	b.WriteString(s.renderField("Cluster Nodes (comma-separated, checks the node name)", s.inputs[instIdxNodeList], instIdxNodeList) + "\n")
+
+	// SYSDBA credentials, or the wallet holding them
+	checkbox := ui.UncheckedStyle.String()
+	if s.useWallet {
+		checkbox = ui.CheckedStyle.String()
+	}
+	walletStyle := ui.NormalItemStyle
+	if s.focused() == instPosWallet {
+		walletStyle = ui.SelectedItemStyle
+	}
+	b.WriteString(fmt.Sprintf("\n%s %s\n", checkbox, walletStyle.Render("Use wallet for SYS credentials")))
+	b.WriteString(ui.SubtitleStyle.Render("    Press 'w' to toggle") + "\n\n")
+	if s.useWallet {
+		b.WriteString(s.renderField("Wallet Location", s.inputs[instIdxWalletLocation], instIdxWalletLocation) + "\n")
+		b.WriteString(s.renderField("Wallet Alias (connect string of the credential)", s.inputs[instIdxWalletAlias], instIdxWalletAlias) + "\n")
+	} else {
+		b.WriteString(s.renderField("SYS Password", s.inputs[instIdxSysPassword], instIdxSysPassword) + "\n")
+	}
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+func (s *InstanceStep) renderField(label string, input textinput.Model, index int) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if s.focused() == index {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *InstanceStep) Title() string {
+	if s.config != nil && s.config.Operation == model.OperationDeleteInstance {
+		return "Delete Instance"
+	}
+	return "Add Instance"
+}
+
+// Apply applies the step's changes to the config
+func (s *InstanceStep) Apply(config *model.DBConfig) {
+	config.GlobalDBName = strings.TrimSpace(s.inputs[instIdxGlobalName].Value())
+	config.InstanceNode = strings.TrimSpace(s.inputs[instIdxNode].Value())
+	config.InstanceName = strings.TrimSpace(s.inputs[instIdxInstance].Value())
+	config.NodeList = strings.Join(validation.SplitNodeList(s.inputs[instIdxNodeList].Value()), ",")
+	config.SysPassword = s.inputs[instIdxSysPassword].Value()
+	config.UseWallet = s.useWallet
+	config.WalletLocation = strings.TrimSpace(s.inputs[instIdxWalletLocation].Value())
+	config.WalletAlias = strings.TrimSpace(s.inputs[instIdxWalletAlias].Value())
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *InstanceStep) ShouldSkip(config *model.DBConfig) bool {
+	return !config.Operation.IsInstance()
+}
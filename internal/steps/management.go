@@ -1,11 +1,13 @@
 package steps
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
 
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -111,6 +113,11 @@ func (s *ManagementStep) updateEMSelection(msg tea.Msg) (wizard.Step, wizard.Ste
 			s.emList.Update(msg)
 			if s.emList.IsSelected() {
 				emConfig := model.EMConfiguration(s.emList.GetSelectedValue())
+				s.err = ""
+				if emConfig == model.EMConfigDBExpress && !s.config.TargetVersion.SupportsEMExpress() {
+					s.err = fmt.Sprintf("EM Express is not available in Oracle %s", s.config.TargetVersion)
+					return s, wizard.StepStay, nil
+				}
 				if emConfig == model.EMConfigNone {
 					return s, wizard.StepContinue, nil
 				}
@@ -152,7 +159,7 @@ func (s *ManagementStep) updatePortConfig(msg tea.Msg) (wizard.Step, wizard.Step
 			return s, wizard.StepStay, nil
 
 		case "enter":
-			if s.validate(emConfig) {
+			if s.validate() {
 				return s, wizard.StepContinue, nil
 			}
 			return s, wizard.StepStay, nil
@@ -173,27 +180,11 @@ func (s *ManagementStep) updatePortConfig(msg tea.Msg) (wizard.Step, wizard.Step
 	return s, wizard.StepStay, cmd
 }
 
-func (s *ManagementStep) validate(emConfig model.EMConfiguration) bool {
+func (s *ManagementStep) validate() bool {
 	s.err = ""
 
-	if emConfig == model.EMConfigDBExpress || emConfig == model.EMConfigCentral {
-		portStr := strings.TrimSpace(s.portInput.Value())
-		port, err := strconv.Atoi(portStr)
-		if err != nil || port < 1 || port > 65535 {
-			s.err = "Port must be between 1 and 65535"
-			return false
-		}
-	}
-
-	if emConfig == model.EMConfigCentral {
-		agent := strings.TrimSpace(s.agentInput.Value())
-		if agent == "" {
-			s.err = "Cloud Control agent URL is required"
-			return false
-		}
-	}
-
-	return true
+	s.err = validateStep(s, s.config, validation.Management)
+	return s.err == ""
 }
 
 // View renders the step
@@ -203,6 +194,9 @@ func (s *ManagementStep) View() string {
 	if s.phase == 0 {
 		b.WriteString(ui.SubtitleStyle.Render("Configure database management options:") + "\n\n")
 		b.WriteString(s.emList.View())
+		if s.err != "" {
+			b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+		}
 	} else {
 		emConfig := model.EMConfiguration(s.emList.GetSelectedValue())
 		emItem := s.emList.GetSelectedItem()
@@ -262,6 +256,13 @@ func (s *ManagementStep) Apply(config *model.DBConfig) {
 
 // ShouldSkip returns whether this step should be skipped
 func (s *ManagementStep) ShouldSkip(config *model.DBConfig) bool {
-	// Skip for delete operation or in typical mode
-	return config.Operation != model.OperationCreate || config.CreationMode == model.CreationModeTypical
+	// Shown when configuring a database, and when creating one in advanced mode
+	switch config.Operation {
+	case model.OperationConfigure:
+		return false
+	case model.OperationCreate:
+		return config.CreationMode == model.CreationModeTypical
+	default:
+		return true
+	}
 }
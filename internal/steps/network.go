@@ -6,6 +6,7 @@ import (
 
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -139,20 +140,8 @@ func (s *NetworkStep) prevField() {
 func (s *NetworkStep) validate() bool {
 	s.err = ""
 
-	listenerName := strings.TrimSpace(s.inputs[netIdxListenerName].Value())
-	if listenerName == "" {
-		s.err = "Listener name is required"
-		return false
-	}
-
-	portStr := strings.TrimSpace(s.inputs[netIdxListenerPort].Value())
-	port, err := strconv.Atoi(portStr)
-	if err != nil || port < 1 || port > 65535 {
-		s.err = "Port must be between 1 and 65535"
-		return false
-	}
-
-	return true
+	s.err = validateStep(s, s.config, validation.Network)
+	return s.err == ""
 }
 
 // View renders the step
@@ -217,6 +206,13 @@ func (s *NetworkStep) Apply(config *model.DBConfig) {
 
 // ShouldSkip returns whether this step should be skipped
 func (s *NetworkStep) ShouldSkip(config *model.DBConfig) bool {
-	// Skip for delete operation or in typical mode
-	return config.Operation != model.OperationCreate || config.CreationMode == model.CreationModeTypical
+	// A duplicate always names its listener; a new database only in advanced mode
+	switch config.Operation {
+	case model.OperationDuplicate:
+		return false
+	case model.OperationCreate:
+		return config.CreationMode == model.CreationModeTypical
+	default:
+		return true
+	}
 }
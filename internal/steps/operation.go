@@ -8,7 +8,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 )
 
-// OperationStep handles the operation selection (Create/Delete)
+// OperationStep handles the operation selection
 type OperationStep struct {
 	list   ui.SelectList
 	config *model.DBConfig
@@ -27,6 +27,56 @@ func NewOperationStep() *OperationStep {
 			Description: "Generate command to delete an existing Oracle database",
 			Value:       string(model.OperationDelete),
 		},
+		{
+			Title:       "Configure a Database",
+			Description: "Enable ARCHIVELOG, EM, Database Vault or Label Security on an existing database",
+			Value:       string(model.OperationConfigure),
+		},
+		{
+			Title:       "Create a Template from a Database",
+			Description: "Save the structure, or a clone with the datafiles, of an existing database as a DBCA template",
+			Value:       string(model.OperationTemplate),
+		},
+		{
+			Title:       "Duplicate a Database",
+			Description: "Copy a primary database over the network, or build a Data Guard physical standby",
+			Value:       string(model.OperationDuplicate),
+		},
+		{
+			Title:       "Add an Instance",
+			Description: "Add an instance on another cluster node to a RAC database",
+			Value:       string(model.OperationAddInstance),
+		},
+		{
+			Title:       "Delete an Instance",
+			Description: "Generate command to remove an instance from a RAC database",
+			Value:       string(model.OperationDeleteInstance),
+		},
+		{
+			Title:       "Create a Pluggable Database",
+			Description: "Add a PDB to an existing container database",
+			Value:       string(model.OperationCreatePDB),
+		},
+		{
+			Title:       "Delete a Pluggable Database",
+			Description: "Generate command to drop a PDB and its datafiles",
+			Value:       string(model.OperationDeletePDB),
+		},
+		{
+			Title:       "Unplug a Pluggable Database",
+			Description: "Unplug a PDB into an archive, RMAN backup or XML file",
+			Value:       string(model.OperationUnplugPDB),
+		},
+		{
+			Title:       "Plug in a Pluggable Database",
+			Description: "Plug an unplugged PDB into a container database",
+			Value:       string(model.OperationPlugPDB),
+		},
+		{
+			Title:       "Relocate a Pluggable Database",
+			Description: "Move a PDB from a remote container database over a database link",
+			Value:       string(model.OperationRelocatePDB),
+		},
 	}
 
 	return &OperationStep{
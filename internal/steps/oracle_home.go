@@ -0,0 +1,223 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/hostprobe"
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// OracleHomeStep handles the choice of the Oracle home that runs dbca
+type OracleHomeStep struct {
+	list      ui.SelectList
+	homeInput textinput.Model
+	phase     int // 0=home list, 1=other home
+	config    *model.DBConfig
+	err       string
+}
+
+// List values of the items that are not a detected home; homes are absolute paths
+const (
+	homePathValue  = "path"
+	homeOtherValue = "other"
+)
+
+// NewOracleHomeStep creates a new Oracle home step
+func NewOracleHomeStep() *OracleHomeStep {
+	var items []ui.SelectItem
+	for _, home := range hostprobe.DetectOracleHomes() {
+		description := "Found in " + strings.Join(home.Sources, ", ")
+		if home.Name != "" {
+			description = home.Name + " - " + description
+		}
+		if !home.HasDBCA {
+			description += " (bin/dbca not found on this host)"
+		}
+		items = append(items, ui.SelectItem{
+			Title:       home.Path,
+			Description: description,
+			Value:       home.Path,
+		})
+	}
+	items = append(items,
+		ui.SelectItem{
+			Title:       "dbca from the PATH",
+			Description: "Run dbca without a path; $ORACLE_HOME/bin must be on the PATH",
+			Value:       homePathValue,
+		},
+		ui.SelectItem{
+			Title:       "Other Oracle home",
+			Description: "Enter the home of another host or one missing from oratab and the inventory",
+			Value:       homeOtherValue,
+		},
+	)
+
+	s := &OracleHomeStep{
+		list:      ui.NewSelectList(items),
+		homeInput: textinput.New(),
+	}
+	s.homeInput.Placeholder = "/u01/app/oracle/product/19.0.0/dbhome_1"
+	s.homeInput.CharLimit = 512
+
+	return s
+}
+
+// Init initializes the step
+func (s *OracleHomeStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.phase = 0
+	s.err = ""
+	s.list.Reset()
+	s.homeInput.Blur()
+	s.homeInput.SetValue("")
+
+	// A home that was not detected is shown as the other home
+	selected := config.OracleHome
+	switch {
+	case config.OracleHome == "":
+		selected = homePathValue
+	case !s.isDetected(config.OracleHome):
+		selected = homeOtherValue
+		s.homeInput.SetValue(config.OracleHome)
+	}
+
+	// Without a previous choice the first detected home is proposed
+	s.list.Cursor = 0
+	if config.OracleHome != "" || len(s.list.Items) == 2 {
+		for i, item := range s.list.Items {
+			if item.Value == selected {
+				s.list.Cursor = i
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// isDetected returns true if the home is one of the listed homes
+func (s *OracleHomeStep) isDetected(home string) bool {
+	for _, item := range s.list.Items {
+		if item.Value == home {
+			return true
+		}
+	}
+	return false
+}
+
+// Update handles messages
+func (s *OracleHomeStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if s.phase == 1 {
+		return s.updateOther(msg)
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return s, wizard.StepBack, nil
+		case "enter", " ":
+			s.list.Update(msg)
+			if s.list.GetSelectedValue() == homeOtherValue {
+				s.phase = 1
+				s.err = ""
+				s.homeInput.Focus()
+				return s, wizard.StepStay, textinput.Blink
+			}
+			if s.list.IsSelected() {
+				return s, wizard.StepContinue, nil
+			}
+		default:
+			s.list.Update(msg)
+		}
+	}
+
+	return s, wizard.StepStay, nil
+}
+
+func (s *OracleHomeStep) updateOther(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc":
+			s.phase = 0
+			s.err = ""
+			s.list.Reset()
+			s.homeInput.Blur()
+			return s, wizard.StepStay, nil
+		case "enter":
+			if strings.TrimSpace(s.homeInput.Value()) == "" {
+				s.err = "ORACLE_HOME is required"
+			} else {
+				s.err = validateStep(s, s.config, validation.OracleHome)
+			}
+			if s.err == "" {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	s.homeInput, cmd = s.homeInput.Update(msg)
+	return s, wizard.StepStay, cmd
+}
+
+// View renders the step
+func (s *OracleHomeStep) View() string {
+	if s.phase == 0 {
+		return ui.SubtitleStyle.Render("Select the Oracle home to run dbca from:") + "\n\n" + s.list.View()
+	}
+
+	var b strings.Builder
+
+	b.WriteString(ui.SubtitleStyle.Render("Enter the Oracle home:") + "\n\n")
+	b.WriteString(lipgloss.JoinVertical(lipgloss.Left,
+		ui.LabelStyle.Render("ORACLE_HOME (absolute path)"),
+		ui.FocusedInputStyle.Render(s.homeInput.View()),
+	) + "\n")
+	if home := strings.TrimSpace(s.homeInput.Value()); home != "" {
+		b.WriteString(ui.SubtitleStyle.Render(fmt.Sprintf("    Runs %s/bin/dbca", strings.TrimSuffix(home, "/"))) + "\n")
+	}
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue, Esc to choose another home"))
+
+	return b.String()
+}
+
+// Title returns the step title
+func (s *OracleHomeStep) Title() string {
+	return "Oracle Home"
+}
+
+// Apply applies the step's changes to the config
+func (s *OracleHomeStep) Apply(config *model.DBConfig) {
+	if s.phase == 1 {
+		config.OracleHome = strings.TrimSuffix(strings.TrimSpace(s.homeInput.Value()), "/")
+		return
+	}
+
+	switch value := s.list.GetSelectedValue(); value {
+	case homePathValue:
+		config.OracleHome = ""
+	case "", homeOtherValue:
+	default:
+		config.OracleHome = value
+	}
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *OracleHomeStep) ShouldSkip(config *model.DBConfig) bool {
+	return false
+}
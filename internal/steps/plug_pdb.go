@@ -0,0 +1,384 @@
+package steps
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PlugPDBStep handles the plug pluggable database configuration
+type PlugPDBStep struct {
+	config      *model.DBConfig
+	archiveList ui.SelectList
+	inputs      []textinput.Model
+	phase       int // 0=archive type, 1=PDB and file details
+	focusIndex  int // Index into visibleFields, or a toggle after them
+	asClone     bool
+	copyFiles   bool
+	err         string
+}
+
+const (
+	ppIdxCDB = iota
+	ppIdxPDBName
+	ppIdxArchiveFile
+	ppIdxBackupFile
+	ppIdxXMLFile
+	ppIdxSourceConvert
+	ppIdxConvert
+	ppIdxSysPassword
+)
+
+// NewPlugPDBStep creates a new plug PDB step
+func NewPlugPDBStep() *PlugPDBStep {
+	archiveItems := []ui.SelectItem{
+		{
+			Title:       "TAR archive",
+			Description: "Plug in from a .tar.gz archive written by an unplug",
+			Value:       string(model.PDBArchiveTAR),
+		},
+		{
+			Title:       "RMAN backup",
+			Description: "Restore an RMAN backup described by an XML metadata file",
+			Value:       string(model.PDBArchiveRMAN),
+		},
+		{
+			Title:       "XML metadata file",
+			Description: "Plug in datafiles described by an XML metadata file",
+			Value:       string(model.PDBArchiveNone),
+		},
+	}
+
+	s := &PlugPDBStep{
+		archiveList: ui.NewSelectList(archiveItems),
+		inputs:      make([]textinput.Model, 8),
+	}
+
+	placeholders := []string{
+		ppIdxCDB:           "orcl",
+		ppIdxPDBName:       "salespdb",
+		ppIdxArchiveFile:   "/u01/app/oracle/unplug/salespdb.tar.gz",
+		ppIdxBackupFile:    "/u01/app/oracle/unplug/salespdb.bkp",
+		ppIdxXMLFile:       "/u01/app/oracle/unplug/salespdb.xml",
+		ppIdxSourceConvert: "'/old/oradata/SALESPDB/','/u01/unplug/SALESPDB/'",
+		ppIdxConvert:       "'/u01/unplug/SALESPDB/','/u01/oradata/ORCL/salespdb/'",
+		ppIdxSysPassword:   "Empty for OS authentication",
+	}
+	limits := []int{
+		ppIdxCDB:           12,
+		ppIdxPDBName:       30,
+		ppIdxArchiveFile:   512,
+		ppIdxBackupFile:    512,
+		ppIdxXMLFile:       512,
+		ppIdxSourceConvert: 1024,
+		ppIdxConvert:       1024,
+		ppIdxSysPassword:   30,
+	}
+	for i := range s.inputs {
+		s.inputs[i] = textinput.New()
+		s.inputs[i].Placeholder = placeholders[i]
+		s.inputs[i].CharLimit = limits[i]
+	}
+	s.inputs[ppIdxSysPassword].EchoMode = textinput.EchoPassword
+	s.inputs[ppIdxSysPassword].EchoCharacter = '*'
+
+	return s
+}
+
+// Init initializes the step
+func (s *PlugPDBStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.phase = 0
+	s.focusIndex = 0
+	s.err = ""
+	s.asClone = config.PDBCreateAsClone
+	s.copyFiles = config.PDBCopyFiles
+	s.archiveList.Reset()
+
+	for i, item := range s.archiveList.Items {
+		if item.Value == string(config.PDBArchiveType) {
+			s.archiveList.Cursor = i
+			break
+		}
+	}
+
+	s.inputs[ppIdxCDB].SetValue(config.SourceCDB)
+	s.inputs[ppIdxPDBName].SetValue(config.NewPDBName)
+	s.inputs[ppIdxArchiveFile].SetValue(config.PDBArchiveFile)
+	s.inputs[ppIdxBackupFile].SetValue(config.PDBBackupFile)
+	s.inputs[ppIdxXMLFile].SetValue(config.PDBMetadataFile)
+	s.inputs[ppIdxSourceConvert].SetValue(config.PDBSourceFileNameConvert)
+	s.inputs[ppIdxConvert].SetValue(config.PDBFileNameConvert)
+	s.inputs[ppIdxSysPassword].SetValue(config.SysPassword)
+
+	return nil
+}
+
+// Update handles messages
+func (s *PlugPDBStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "esc" {
+		if s.phase > 0 {
+			s.phase = 0
+			s.err = ""
+			s.blurAll()
+			return s, wizard.StepStay, nil
+		}
+		return s, wizard.StepBack, nil
+	}
+
+	if s.phase == 0 {
+		return s.updateArchiveType(msg)
+	}
+	return s.updateDetails(msg)
+}
+
+func (s *PlugPDBStep) updateArchiveType(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter", " ":
+			s.archiveList.Update(msg)
+			if s.archiveList.IsSelected() {
+				s.phase = 1
+				s.focusIndex = 0
+				s.blurAll()
+				s.inputs[s.visibleFields()[0]].Focus()
+				return s, wizard.StepStay, textinput.Blink
+			}
+		default:
+			s.archiveList.Update(msg)
+		}
+	}
+	return s, wizard.StepStay, nil
+}
+
+func (s *PlugPDBStep) updateDetails(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	fields := s.visibleFields()
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+
+		case "c", "C":
+			// Toggle clone when not in text input
+			if s.focusIndex == len(fields) {
+				s.asClone = !s.asClone
+			}
+
+		case "f", "F":
+			// Toggle copying of the datafiles when not in text input
+			if s.focusIndex == len(fields)+1 {
+				s.copyFiles = !s.copyFiles
+			}
+		}
+	}
+
+	// Update the focused text input
+	if s.focusIndex < len(fields) {
+		var cmd tea.Cmd
+		idx := fields[s.focusIndex]
+		s.inputs[idx], cmd = s.inputs[idx].Update(msg)
+		return s, wizard.StepStay, cmd
+	}
+
+	return s, wizard.StepStay, nil
+}
+
+// visibleFields returns the inputs shown for the selected archive type
+func (s *PlugPDBStep) visibleFields() []int {
+	fields := []int{ppIdxCDB, ppIdxPDBName}
+
+	switch s.archiveType() {
+	case model.PDBArchiveTAR:
+		fields = append(fields, ppIdxArchiveFile)
+	case model.PDBArchiveRMAN:
+		fields = append(fields, ppIdxBackupFile, ppIdxXMLFile)
+	default:
+		fields = append(fields, ppIdxXMLFile)
+	}
+
+	return append(fields, ppIdxSourceConvert, ppIdxConvert, ppIdxSysPassword)
+}
+
+// toggleCount returns the number of checkboxes after the inputs; copying
+// only applies to datafiles described by an XML file
+func (s *PlugPDBStep) toggleCount() int {
+	if s.archiveType() == model.PDBArchiveNone {
+		return 2
+	}
+	return 1
+}
+
+func (s *PlugPDBStep) archiveType() model.PDBArchiveType {
+	return model.PDBArchiveType(s.archiveList.GetSelectedValue())
+}
+
+// moveFocus cycles through the visible inputs and the toggles
+func (s *PlugPDBStep) moveFocus(delta int) {
+	fields := s.visibleFields()
+	positions := len(fields) + s.toggleCount()
+
+	s.blurAll()
+	s.focusIndex = (s.focusIndex + delta + positions) % positions
+	if s.focusIndex < len(fields) {
+		s.inputs[fields[s.focusIndex]].Focus()
+	}
+}
+
+func (s *PlugPDBStep) blurAll() {
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+}
+
+func (s *PlugPDBStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.PlugPDB)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *PlugPDBStep) View() string {
+	var b strings.Builder
+
+	if s.phase == 0 {
+		b.WriteString(ui.SubtitleStyle.Render("How was the pluggable database unplugged?") + "\n\n")
+		b.WriteString(s.archiveList.View())
+		return b.String()
+	}
+
+	b.WriteString(ui.SubtitleStyle.Render("Plug in from "+s.archiveList.GetSelectedItem().Title) + "\n\n")
+
+	fields := s.visibleFields()
+	for i, idx := range fields {
+		b.WriteString(s.renderField(s.fieldLabel(idx), s.inputs[idx], s.focusIndex == i) + "\n")
+	}
+
+	// Clone toggle
+	b.WriteString("\n" + s.renderToggle("Plug in as a clone (new GUID and DBID)", s.asClone, s.focusIndex == len(fields)))
+	b.WriteString(ui.SubtitleStyle.Render("    Press 'c' to toggle") + "\n")
+
+	// Copy toggle
+	if s.toggleCount() > 1 {
+		b.WriteString(s.renderToggle("Copy the datafiles (keep the originals)", s.copyFiles, s.focusIndex == len(fields)+1))
+		b.WriteString(ui.SubtitleStyle.Render("    Press 'f' to toggle") + "\n")
+	}
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+// fieldLabel returns the label of an input
+func (s *PlugPDBStep) fieldLabel(idx int) string {
+	switch idx {
+	case ppIdxCDB:
+		return "Container Database SID"
+	case ppIdxPDBName:
+		return "PDB Name"
+	case ppIdxArchiveFile:
+		return "Archive File (.tar.gz)"
+	case ppIdxBackupFile:
+		return "RMAN Backup File"
+	case ppIdxXMLFile:
+		return "XML Metadata File"
+	case ppIdxSourceConvert:
+		return "Source File Name Convert (optional; remaps locations in the XML file)"
+	case ppIdxConvert:
+		return "File Name Convert (optional; source,target pairs)"
+	default:
+		return "SYS Password (optional)"
+	}
+}
+
+func (s *PlugPDBStep) renderToggle(label string, checked, focused bool) string {
+	checkbox := ui.UncheckedStyle.String()
+	if checked {
+		checkbox = ui.CheckedStyle.String()
+	}
+	style := ui.NormalItemStyle
+	if focused {
+		style = ui.SelectedItemStyle
+	}
+	return fmt.Sprintf("%s %s\n", checkbox, style.Render(label))
+}
+
+func (s *PlugPDBStep) renderField(label string, input textinput.Model, focused bool) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if focused {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *PlugPDBStep) Title() string {
+	return "Plug Pluggable Database"
+}
+
+// Apply applies the step's changes to the config
+func (s *PlugPDBStep) Apply(config *model.DBConfig) {
+	value := func(idx int) string {
+		return strings.TrimSpace(s.inputs[idx].Value())
+	}
+
+	config.PDBArchiveType = s.archiveType()
+	config.SourceCDB = value(ppIdxCDB)
+	config.NewPDBName = value(ppIdxPDBName)
+	config.PDBSourceFileNameConvert = value(ppIdxSourceConvert)
+	config.PDBFileNameConvert = value(ppIdxConvert)
+	config.PDBCreateAsClone = s.asClone
+	config.PDBCopyFiles = s.copyFiles && config.PDBArchiveType == model.PDBArchiveNone
+	config.SysPassword = s.inputs[ppIdxSysPassword].Value()
+	config.UseOSAuthentication = config.SysPassword == ""
+
+	config.PDBArchiveFile = ""
+	config.PDBBackupFile = ""
+	config.PDBMetadataFile = ""
+	switch config.PDBArchiveType {
+	case model.PDBArchiveTAR:
+		config.PDBArchiveFile = value(ppIdxArchiveFile)
+	case model.PDBArchiveRMAN:
+		config.PDBBackupFile = value(ppIdxBackupFile)
+		config.PDBMetadataFile = value(ppIdxXMLFile)
+	default:
+		config.PDBMetadataFile = value(ppIdxXMLFile)
+	}
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *PlugPDBStep) ShouldSkip(config *model.DBConfig) bool {
+	return config.Operation != model.OperationPlugPDB
+}
@@ -7,6 +7,7 @@ import (
 
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -120,6 +121,10 @@ func (s *RecoveryStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.
 }
 
 func (s *RecoveryStep) nextField() {
+	if s.archiveOnly() {
+		return
+	}
+
 	// Blur current input if focused
 	if s.focusIndex >= 2 && s.focusIndex <= 3 {
 		s.inputs[s.focusIndex-2].Blur()
@@ -142,6 +147,10 @@ func (s *RecoveryStep) nextField() {
 }
 
 func (s *RecoveryStep) prevField() {
+	if s.archiveOnly() {
+		return
+	}
+
 	// Blur current input if focused
 	if s.focusIndex >= 2 && s.focusIndex <= 3 {
 		s.inputs[s.focusIndex-2].Blur()
@@ -163,24 +172,21 @@ func (s *RecoveryStep) prevField() {
 	}
 }
 
+// archiveOnly returns true when only the archive log mode can be changed,
+// as when configuring an existing database
+func (s *RecoveryStep) archiveOnly() bool {
+	return s.config.Operation == model.OperationConfigure
+}
+
 func (s *RecoveryStep) validate() bool {
 	s.err = ""
 
-	if s.enableFRA {
-		fraDest := strings.TrimSpace(s.inputs[recIdxFRADest].Value())
-		if fraDest == "" {
-			s.err = "Fast Recovery Area location is required"
-			return false
-		}
-
-		fraSize, err := strconv.Atoi(strings.TrimSpace(s.inputs[recIdxFRASize].Value()))
-		if err != nil || fraSize < 1024 {
-			s.err = "FRA size must be at least 1024 MB"
-			return false
-		}
+	if s.archiveOnly() {
+		s.err = validateStep(s, s.config, validation.ConfigureArchiveLog)
+	} else {
+		s.err = validateStep(s, s.config, validation.Recovery)
 	}
-
-	return true
+	return s.err == ""
 }
 
 // View renders the step
@@ -209,6 +215,15 @@ func (s *RecoveryStep) View() string {
 	b.WriteString(fmt.Sprintf("%s %s\n", archiveCheckbox, archiveStyle.Render(archiveLabel)))
 	b.WriteString(ui.SubtitleStyle.Render("    Press 'a' to toggle - Required for online backups and point-in-time recovery") + "\n\n")
 
+	if s.archiveOnly() {
+		b.WriteString(ui.SubtitleStyle.Render("Archived logs go to the database's existing Fast Recovery Area or log_archive_dest_n") + "\n")
+		if s.err != "" {
+			b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+		}
+		b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+		return b.String()
+	}
+
 	// Separator
 	b.WriteString(lipgloss.NewStyle().Foreground(ui.MutedColor).Render("─────────────────────────────────────────") + "\n\n")
 
@@ -263,6 +278,10 @@ func (s *RecoveryStep) Title() string {
 // Apply applies the step's changes to the config
 func (s *RecoveryStep) Apply(config *model.DBConfig) {
 	config.EnableArchiveLog = s.enableArchive
+	if s.archiveOnly() {
+		return
+	}
+
 	config.EnableFRA = s.enableFRA
 
 	if s.enableFRA {
@@ -274,5 +293,5 @@ func (s *RecoveryStep) Apply(config *model.DBConfig) {
 
 // ShouldSkip returns whether this step should be skipped
 func (s *RecoveryStep) ShouldSkip(config *model.DBConfig) bool {
-	return config.Operation != model.OperationCreate
+	return config.Operation != model.OperationCreate && config.Operation != model.OperationConfigure
 }
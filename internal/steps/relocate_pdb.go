@@ -0,0 +1,207 @@
+package steps
+
+import (
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RelocatePDBStep handles moving a PDB from a remote CDB into a local one
+type RelocatePDBStep struct {
+	config     *model.DBConfig
+	inputs     []textinput.Model
+	focusIndex int
+	err        string
+}
+
+const (
+	relIdxTargetCDB = iota
+	relIdxRemote
+	relIdxRemotePDB
+	relIdxName
+	relIdxRemoteUser
+	relIdxRemotePassword
+	relIdxLinkUser
+	relIdxLinkPassword
+	relIdxSysPassword
+)
+
+// NewRelocatePDBStep creates a new relocate pluggable database step
+func NewRelocatePDBStep() *RelocatePDBStep {
+	s := &RelocatePDBStep{
+		inputs: make([]textinput.Model, 9),
+	}
+
+	placeholders := []string{
+		relIdxTargetCDB:  "cdb2",
+		relIdxRemote:     "remote-host:1521/cdb1",
+		relIdxRemotePDB:  "pdb1",
+		relIdxName:       "Empty to keep the remote name",
+		relIdxRemoteUser: "SYS",
+		relIdxLinkUser:   "c##dblink_user",
+	}
+	limits := []int{
+		relIdxTargetCDB:  12,
+		relIdxRemote:     256,
+		relIdxRemotePDB:  30,
+		relIdxName:       30,
+		relIdxRemoteUser: 128,
+		relIdxLinkUser:   128,
+	}
+	for _, i := range []int{relIdxTargetCDB, relIdxRemote, relIdxRemotePDB, relIdxName, relIdxRemoteUser, relIdxLinkUser} {
+		s.inputs[i] = textinput.New()
+		s.inputs[i].Placeholder = placeholders[i]
+		s.inputs[i].CharLimit = limits[i]
+	}
+	s.inputs[relIdxRemotePassword] = newPasswordInput("Remote SYSDBA password")
+	s.inputs[relIdxLinkPassword] = newPasswordInput("Database link user password")
+	s.inputs[relIdxSysPassword] = newPasswordInput("Empty for OS authentication")
+
+	return s
+}
+
+// Init initializes the step
+func (s *RelocatePDBStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.focusIndex = 0
+	s.err = ""
+
+	s.inputs[relIdxTargetCDB].SetValue(config.SourceCDB)
+	s.inputs[relIdxRemote].SetValue(config.RemoteCDBConnectString)
+	s.inputs[relIdxRemotePDB].SetValue(config.TargetPDB)
+	name := config.NewPDBName
+	if strings.EqualFold(name, config.TargetPDB) {
+		name = ""
+	}
+	s.inputs[relIdxName].SetValue(name)
+	s.inputs[relIdxRemoteUser].SetValue(config.RemoteSysDBAUser)
+	s.inputs[relIdxRemotePassword].SetValue(config.RemoteSysDBAPassword)
+	s.inputs[relIdxLinkUser].SetValue(config.DBLinkUser)
+	s.inputs[relIdxLinkPassword].SetValue(config.DBLinkPassword)
+	s.inputs[relIdxSysPassword].SetValue(config.SysPassword)
+
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+	s.inputs[0].Focus()
+
+	return textinput.Blink
+}
+
+// Update handles messages
+func (s *RelocatePDBStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return s, wizard.StepBack, nil
+
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+		}
+	}
+
+	// Update the focused text input
+	var cmd tea.Cmd
+	s.inputs[s.focusIndex], cmd = s.inputs[s.focusIndex].Update(msg)
+	return s, wizard.StepStay, cmd
+}
+
+func (s *RelocatePDBStep) moveFocus(delta int) {
+	s.inputs[s.focusIndex].Blur()
+	s.focusIndex = (s.focusIndex + delta + len(s.inputs)) % len(s.inputs)
+	s.inputs[s.focusIndex].Focus()
+}
+
+func (s *RelocatePDBStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.RelocatePDB)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *RelocatePDBStep) View() string {
+	var b strings.Builder
+
+	b.WriteString(ui.SubtitleStyle.Render("Relocate a PDB from a remote container database:") + "\n\n")
+
+	b.WriteString(s.renderField("Target Container Database SID (local)", s.inputs[relIdxTargetCDB], relIdxTargetCDB) + "\n")
+	b.WriteString(s.renderField("Source CDB Connect String (host:port/service)", s.inputs[relIdxRemote], relIdxRemote) + "\n")
+	b.WriteString(s.renderField("PDB to Relocate", s.inputs[relIdxRemotePDB], relIdxRemotePDB) + "\n")
+	b.WriteString(s.renderField("New PDB Name (optional)", s.inputs[relIdxName], relIdxName) + "\n")
+	b.WriteString(s.renderField("Source CDB SYSDBA User", s.inputs[relIdxRemoteUser], relIdxRemoteUser) + "\n")
+	b.WriteString(s.renderField("Source CDB SYSDBA Password", s.inputs[relIdxRemotePassword], relIdxRemotePassword) + "\n")
+	b.WriteString(s.renderField("Database Link User (common user in the source CDB)", s.inputs[relIdxLinkUser], relIdxLinkUser) + "\n")
+	b.WriteString(s.renderField("Database Link User Password", s.inputs[relIdxLinkPassword], relIdxLinkPassword) + "\n")
+	b.WriteString(s.renderField("Local SYS Password (optional)", s.inputs[relIdxSysPassword], relIdxSysPassword) + "\n")
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("DBCA creates the database link; the PDB is closed in the source CDB once relocated") + "\n")
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+func (s *RelocatePDBStep) renderField(label string, input textinput.Model, index int) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if s.focusIndex == index {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *RelocatePDBStep) Title() string {
+	return "Relocate PDB"
+}
+
+// Apply applies the step's changes to the config
+func (s *RelocatePDBStep) Apply(config *model.DBConfig) {
+	config.SourceCDB = strings.TrimSpace(s.inputs[relIdxTargetCDB].Value())
+	config.RemoteCDBConnectString = strings.TrimSpace(s.inputs[relIdxRemote].Value())
+	config.TargetPDB = strings.TrimSpace(s.inputs[relIdxRemotePDB].Value())
+	config.NewPDBName = strings.TrimSpace(s.inputs[relIdxName].Value())
+	if config.NewPDBName == "" {
+		config.NewPDBName = config.TargetPDB
+	}
+	config.RemoteSysDBAUser = strings.TrimSpace(s.inputs[relIdxRemoteUser].Value())
+	config.RemoteSysDBAPassword = s.inputs[relIdxRemotePassword].Value()
+	config.DBLinkUser = strings.TrimSpace(s.inputs[relIdxLinkUser].Value())
+	config.DBLinkPassword = s.inputs[relIdxLinkPassword].Value()
+	config.SysPassword = s.inputs[relIdxSysPassword].Value()
+	config.UseOSAuthentication = config.SysPassword == ""
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *RelocatePDBStep) ShouldSkip(config *model.DBConfig) bool {
+	return config.Operation != model.OperationRelocatePDB
+}
@@ -5,6 +5,7 @@ import (
 
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -149,7 +150,7 @@ func (s *StorageStep) updatePathInput(msg tea.Msg) (wizard.Step, wizard.StepResu
 			return s, wizard.StepStay, nil
 
 		case "enter":
-			if s.validate(storageType) {
+			if s.validate() {
 				return s, wizard.StepContinue, nil
 			}
 			return s, wizard.StepStay, nil
@@ -178,6 +179,9 @@ func (s *StorageStep) getMaxInputs(storageType model.StorageType) int {
 	if storageType == model.StorageTypeASM {
 		return 1 // Just ASM disk group
 	}
+	if s.config.Operation == model.OperationDuplicate {
+		return 1 // The duplicate places its redo logs with the datafiles
+	}
 	return 2 // Datafile and redo log paths
 }
 
@@ -215,24 +219,11 @@ func (s *StorageStep) prevField(storageType model.StorageType) {
 	}
 }
 
-func (s *StorageStep) validate(storageType model.StorageType) bool {
+func (s *StorageStep) validate() bool {
 	s.err = ""
 
-	if storageType == model.StorageTypeASM {
-		asmDG := strings.TrimSpace(s.inputs[stgIdxASMDiskGroup].Value())
-		if asmDG == "" {
-			s.err = "ASM Disk Group is required"
-			return false
-		}
-	} else {
-		datafile := strings.TrimSpace(s.inputs[stgIdxDatafile].Value())
-		if datafile == "" {
-			s.err = "Datafile destination is required"
-			return false
-		}
-	}
-
-	return true
+	s.err = validateStep(s, s.config, validation.Storage)
+	return s.err == ""
 }
 
 // View renders the step
@@ -251,7 +242,9 @@ func (s *StorageStep) View() string {
 		} else {
 			b.WriteString(ui.SubtitleStyle.Render("Configure file system storage:") + "\n\n")
 			b.WriteString(s.renderField("Database Files Location", s.inputs[stgIdxDatafile], 0) + "\n")
-			b.WriteString(s.renderField("Redo Log Files Location", s.inputs[stgIdxRedoLog], 1) + "\n")
+			if s.config.Operation != model.OperationDuplicate {
+				b.WriteString(s.renderField("Redo Log Files Location", s.inputs[stgIdxRedoLog], 1) + "\n")
+			}
 		}
 
 		// OMF Toggle
@@ -317,5 +310,5 @@ func (s *StorageStep) Apply(config *model.DBConfig) {
 
 // ShouldSkip returns whether this step should be skipped
 func (s *StorageStep) ShouldSkip(config *model.DBConfig) bool {
-	return config.Operation != model.OperationCreate
+	return config.Operation != model.OperationCreate && config.Operation != model.OperationDuplicate
 }
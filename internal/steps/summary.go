@@ -3,11 +3,15 @@ package steps
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"dbca_tui/internal/generator"
+	"dbca_tui/internal/hostprobe"
 	"dbca_tui/internal/model"
+	"dbca_tui/internal/profile"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -18,14 +22,18 @@ import (
 type SummaryStep struct {
 	config        *model.DBConfig
 	showPasswords bool
+	secretMode    generator.SecretMode // How the saved script gets its passwords
 	saved         bool
+	savedRsp      bool
+	savedProfile  bool
 	saveError     string
 	focusIndex    int
+	host          *hostprobe.HostInfo // Local host the memory settings are checked against
 }
 
 // NewSummaryStep creates a new summary step
 func NewSummaryStep() *SummaryStep {
-	return &SummaryStep{}
+	return &SummaryStep{secretMode: generator.SecretsEnv}
 }
 
 // Init initializes the step
@@ -33,8 +41,13 @@ func (s *SummaryStep) Init(config *model.DBConfig) tea.Cmd {
 	s.config = config
 	s.showPasswords = false
 	s.saved = false
+	s.savedRsp = false
+	s.savedProfile = false
 	s.saveError = ""
 	s.focusIndex = 0
+	if config.Operation == model.OperationCreate {
+		s.host, _ = hostprobe.Probe()
+	}
 	return nil
 }
 
@@ -52,9 +65,21 @@ func (s *SummaryStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.C
 		case "s", "S":
 			s.saveToFile()
 
+		case "m", "M":
+			s.cycleSecretMode()
+
+		case "r", "R":
+			s.saveResponseFile()
+
+		case "f", "F":
+			s.saveProfile()
+
 		case "g", "G", "enter":
 			// Generate command and exit
 			if s.focusIndex == 0 {
+				if s.saveError = s.validationErrors(false); s.saveError != "" {
+					return s, wizard.StepStay, nil
+				}
 				return s, wizard.StepPrintAndQuit, nil
 			}
 			// Handle other focused items
@@ -64,6 +89,12 @@ func (s *SummaryStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.C
 			case 2:
 				s.saveToFile()
 			case 3:
+				s.cycleSecretMode()
+			case 4:
+				s.saveResponseFile()
+			case 5:
+				s.saveProfile()
+			case 6:
 				return s, wizard.StepQuit, nil
 			}
 
@@ -76,7 +107,7 @@ func (s *SummaryStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.C
 			}
 
 		case "down", "j":
-			if s.focusIndex < 3 {
+			if s.focusIndex < 6 {
 				s.focusIndex++
 			}
 		}
@@ -85,22 +116,27 @@ func (s *SummaryStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.C
 	return s, wizard.StepStay, nil
 }
 
-func (s *SummaryStep) saveToFile() {
-	var filename string
-	if s.config.Operation == model.OperationDelete {
-		filename = fmt.Sprintf("dbca_delete_%s.sh", s.config.DeleteSID)
-	} else {
-		filename = fmt.Sprintf("dbca_%s.sh", s.config.SID)
+// validationErrors returns why the command cannot be generated, or an
+// empty string. Output that leaves the passwords out does not need them.
+func (s *SummaryStep) validationErrors(omitsSecrets bool) string {
+	findings := validation.Validate(s.config, s.host)
+	if omitsSecrets {
+		findings = validation.WithoutSecrets(findings)
 	}
+	if n := validation.Count(findings, validation.SeverityError); n > 0 {
+		return fmt.Sprintf("Fix the %d validation error(s) in the report before generating", n)
+	}
+	return ""
+}
 
-	opType := "Create"
-	if s.config.Operation == model.OperationDelete {
-		opType = "Delete"
+func (s *SummaryStep) saveToFile() {
+	filename := s.baseName() + ".sh"
+	if s.saveError = s.validationErrors(s.secretMode != generator.SecretsInline); s.saveError != "" {
+		s.saved = false
+		return
 	}
 
-	content := fmt.Sprintf("#!/bin/bash\n# DBCA Silent Mode Command - %s Database\n# Generated by DBCA TUI\n\n", opType)
-	content += generator.GenerateCommandWithPasswords(s.config)
-	content += "\n"
+	content := generator.GenerateScript(s.config, s.secretMode)
 
 	err := os.WriteFile(filename, []byte(content), 0600)
 	if err != nil {
@@ -112,16 +148,157 @@ func (s *SummaryStep) saveToFile() {
 	}
 }
 
+// cycleSecretMode selects the next way the saved script gets its passwords
+func (s *SummaryStep) cycleSecretMode() {
+	modes := generator.SecretModes
+	s.secretMode = modes[(slices.Index(modes, s.secretMode)+1)%len(modes)]
+	s.saved = false
+}
+
+func (s *SummaryStep) saveResponseFile() {
+	filename := s.baseName() + ".rsp"
+	if s.saveError = s.validationErrors(false); s.saveError != "" {
+		s.savedRsp = false
+		return
+	}
+
+	content := generator.GenerateResponseFileWithPasswords(s.config)
+
+	err := os.WriteFile(filename, []byte(content), 0600)
+	if err != nil {
+		s.saveError = fmt.Sprintf("Error saving file: %v", err)
+		s.savedRsp = false
+	} else {
+		s.savedRsp = true
+		s.saveError = ""
+	}
+}
+
+func (s *SummaryStep) saveProfile() {
+	filename := s.baseName() + ".yaml"
+
+	// Profiles are meant to be shared, so passwords are never written here
+	err := profile.Save(filename, s.config, false)
+	if err != nil {
+		s.saveError = fmt.Sprintf("Error saving file: %v", err)
+		s.savedProfile = false
+	} else {
+		s.savedProfile = true
+		s.saveError = ""
+	}
+}
+
+// baseName returns the file name, without extension, used when saving
+func (s *SummaryStep) baseName() string {
+	switch s.config.Operation {
+	case model.OperationDelete:
+		return fmt.Sprintf("dbca_delete_%s", s.config.DeleteSID)
+	case model.OperationCreatePDB:
+		return fmt.Sprintf("dbca_create_pdb_%s_%s", s.config.SourceCDB, s.config.NewPDBName)
+	case model.OperationConfigure:
+		return fmt.Sprintf("dbca_configure_%s", s.config.ConfigureSID)
+	case model.OperationTemplate:
+		return fmt.Sprintf("dbca_template_%s", s.config.NewTemplateName)
+	case model.OperationDuplicate:
+		return fmt.Sprintf("dbca_duplicate_%s", s.config.SID)
+	case model.OperationAddInstance:
+		return fmt.Sprintf("dbca_add_instance_%s", s.config.InstanceNode)
+	case model.OperationDeleteInstance:
+		return fmt.Sprintf("dbca_delete_instance_%s", s.config.InstanceName)
+	case model.OperationDeletePDB:
+		return fmt.Sprintf("dbca_delete_pdb_%s_%s", s.config.SourceCDB, s.config.TargetPDB)
+	case model.OperationUnplugPDB:
+		return fmt.Sprintf("dbca_unplug_pdb_%s_%s", s.config.SourceCDB, s.config.TargetPDB)
+	case model.OperationPlugPDB:
+		return fmt.Sprintf("dbca_plug_pdb_%s_%s", s.config.SourceCDB, s.config.NewPDBName)
+	case model.OperationRelocatePDB:
+		return fmt.Sprintf("dbca_relocate_pdb_%s_%s", s.config.SourceCDB, generator.RelocatedPDBName(s.config))
+	default:
+		return fmt.Sprintf("dbca_%s", s.config.SID)
+	}
+}
+
 // View renders the step
 func (s *SummaryStep) View() string {
 	var b strings.Builder
 
-	if s.config.Operation == model.OperationDelete {
+	switch s.config.Operation {
+	case model.OperationDelete:
 		return s.renderDeleteView(&b)
+	case model.OperationCreatePDB:
+		b.WriteString(ui.SubtitleStyle.Render("Review your pluggable database settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderCreatePDBSummary())
+	case model.OperationConfigure:
+		b.WriteString(ui.SubtitleStyle.Render("Review the changes to the existing database:") + "\n\n")
+		return s.renderOperationView(&b, s.renderConfigureSummary())
+	case model.OperationTemplate:
+		b.WriteString(ui.SubtitleStyle.Render("Review your template settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderTemplateSummary())
+	case model.OperationDuplicate:
+		b.WriteString(ui.SubtitleStyle.Render("Review your duplicate database settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderDuplicateSummary())
+	case model.OperationAddInstance:
+		b.WriteString(ui.SubtitleStyle.Render("Review your instance settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderInstanceSummary("ADD INSTANCE"))
+	case model.OperationDeleteInstance:
+		s.renderPDBWarning(&b, fmt.Sprintf("WARNING: This will generate a command to REMOVE instance %s from %s!",
+			s.config.InstanceName, s.config.GlobalDBName))
+		b.WriteString(ui.SubtitleStyle.Render("Review your instance deletion settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderInstanceSummary("DELETE INSTANCE"))
+	case model.OperationDeletePDB:
+		s.renderPDBWarning(&b, fmt.Sprintf("WARNING: This will generate a command to DROP pluggable database %s of %s including its datafiles!",
+			s.config.TargetPDB, s.config.SourceCDB))
+		b.WriteString(ui.SubtitleStyle.Render("Review your pluggable database deletion settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderPDBSummary("DELETE PLUGGABLE DATABASE"))
+	case model.OperationUnplugPDB:
+		s.renderPDBWarning(&b, fmt.Sprintf("WARNING: This will generate a command to CLOSE and UNPLUG %s from %s!",
+			s.config.TargetPDB, s.config.SourceCDB))
+		b.WriteString(ui.SubtitleStyle.Render("Review your unplug settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderPDBSummary("UNPLUG PLUGGABLE DATABASE"))
+	case model.OperationPlugPDB:
+		b.WriteString(ui.SubtitleStyle.Render("Review your plug-in settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderPDBSummary("PLUG PLUGGABLE DATABASE"))
+	case model.OperationRelocatePDB:
+		b.WriteString(ui.SubtitleStyle.Render("Review your relocation settings:") + "\n\n")
+		return s.renderOperationView(&b, s.renderRelocatePDBSummary())
 	}
 	return s.renderCreateView(&b)
 }
 
+// renderOperationView renders the summary box, validation report, command
+// preview and actions shared by the operation summaries
+func (s *SummaryStep) renderOperationView(b *strings.Builder, summary string) string {
+	b.WriteString(ui.BoxStyle.Render(summary) + "\n\n")
+
+	// Validation report
+	s.renderValidationReport(b)
+
+	// Generated command preview
+	b.WriteString(ui.LabelStyle.Render(fmt.Sprintf("Generated DBCA %s Command (preview):", generator.OperationTitle(s.config))) + "\n")
+
+	var command string
+	if s.showPasswords {
+		command = generator.GenerateCommandWithPasswords(s.config)
+	} else {
+		command = generator.GenerateCommand(s.config)
+	}
+	b.WriteString(ui.CodeBlockStyle.Render(command) + "\n\n")
+
+	// Actions
+	s.renderActions(b, s.baseName())
+
+	return b.String()
+}
+
+// renderPDBWarning renders the red confirmation banner of an operation
+// that removes a PDB from its container
+func (s *SummaryStep) renderPDBWarning(b *strings.Builder, message string) {
+	warningStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#FF5555")).
+		Bold(true)
+	b.WriteString(warningStyle.Render(message) + "\n\n")
+}
+
 func (s *SummaryStep) renderDeleteView(b *strings.Builder) string {
 	// Warning for delete
 	warningStyle := lipgloss.NewStyle().
@@ -134,6 +311,9 @@ func (s *SummaryStep) renderDeleteView(b *strings.Builder) string {
 	// Summary
 	b.WriteString(ui.BoxStyle.Render(s.renderDeleteSummary()) + "\n\n")
 
+	// Validation report
+	s.renderValidationReport(b)
+
 	// Generated command preview
 	b.WriteString(ui.LabelStyle.Render("Generated DBCA Delete Command (preview):") + "\n")
 
@@ -146,7 +326,7 @@ func (s *SummaryStep) renderDeleteView(b *strings.Builder) string {
 	b.WriteString(ui.CodeBlockStyle.Render(command) + "\n\n")
 
 	// Actions
-	s.renderActions(b, fmt.Sprintf("dbca_delete_%s.sh", s.config.DeleteSID))
+	s.renderActions(b, s.baseName())
 
 	return b.String()
 }
@@ -157,6 +337,9 @@ func (s *SummaryStep) renderCreateView(b *strings.Builder) string {
 	// Summary
 	b.WriteString(ui.BoxStyle.Render(s.renderCreateSummary()) + "\n\n")
 
+	// Validation report
+	s.renderValidationReport(b)
+
 	// Generated command preview
 	b.WriteString(ui.LabelStyle.Render("Generated DBCA Command (preview):") + "\n")
 
@@ -169,12 +352,38 @@ func (s *SummaryStep) renderCreateView(b *strings.Builder) string {
 	b.WriteString(ui.CodeBlockStyle.Render(command) + "\n\n")
 
 	// Actions
-	s.renderActions(b, fmt.Sprintf("dbca_%s.sh", s.config.SID))
+	s.renderActions(b, s.baseName())
 
 	return b.String()
 }
 
-func (s *SummaryStep) renderActions(b *strings.Builder, filename string) {
+func (s *SummaryStep) renderValidationReport(b *strings.Builder) {
+	findings := validation.Validate(s.config, s.host)
+	if len(findings) == 0 {
+		b.WriteString(ui.SuccessStyle.Render("Validation: no issues found") + "\n\n")
+		return
+	}
+
+	b.WriteString(ui.LabelStyle.Render(fmt.Sprintf("Validation Report (%d errors, %d warnings, %d info):",
+		validation.Count(findings, validation.SeverityError),
+		validation.Count(findings, validation.SeverityWarning),
+		validation.Count(findings, validation.SeverityInfo))) + "\n")
+
+	for _, f := range findings {
+		line := fmt.Sprintf("[%s] %s: %s", f.RuleID, f.Field, f.Message)
+		switch f.Severity {
+		case validation.SeverityError:
+			b.WriteString("  " + ui.ErrorStyle.Render("✗ "+line) + "\n")
+		case validation.SeverityWarning:
+			b.WriteString("  " + ui.WarningStyle.Render("! "+line) + "\n")
+		default:
+			b.WriteString("  " + lipgloss.NewStyle().Foreground(ui.MutedColor).Render("i "+line) + "\n")
+		}
+	}
+	b.WriteString("\n")
+}
+
+func (s *SummaryStep) renderActions(b *strings.Builder, baseName string) {
 	b.WriteString(ui.LabelStyle.Render("Actions:") + "\n\n")
 
 	// Generate and exit (primary action)
@@ -201,19 +410,57 @@ func (s *SummaryStep) renderActions(b *strings.Builder, filename string) {
 	if s.focusIndex == 2 {
 		actionStyle = ui.SelectedItemStyle
 	}
+	filename := baseName + ".sh"
 	saveText := fmt.Sprintf("Save to file (s) - %s", filename)
 	b.WriteString(fmt.Sprintf("  > %s\n", actionStyle.Render(saveText)))
 
 	if s.saved {
 		b.WriteString(ui.SuccessStyle.Render(fmt.Sprintf("    Saved to %s", filename)) + "\n")
 	}
+
+	// Script passwords
+	actionStyle = ui.NormalItemStyle
+	if s.focusIndex == 3 {
+		actionStyle = ui.SelectedItemStyle
+	}
+	secretText := fmt.Sprintf("Script passwords (m) - %s", s.secretMode.Description())
+	b.WriteString(fmt.Sprintf("  > %s\n", actionStyle.Render(secretText)))
+	if s.secretMode == generator.SecretsInline {
+		b.WriteString(ui.WarningStyle.Render("    The script holds cleartext passwords; do not commit it") + "\n")
+	}
+
+	// Save response file
+	actionStyle = ui.NormalItemStyle
+	if s.focusIndex == 4 {
+		actionStyle = ui.SelectedItemStyle
+	}
+	rspFilename := baseName + ".rsp"
+	rspText := fmt.Sprintf("Save response file (r) - %s", rspFilename)
+	b.WriteString(fmt.Sprintf("  > %s\n", actionStyle.Render(rspText)))
+
+	if s.savedRsp {
+		b.WriteString(ui.SuccessStyle.Render(fmt.Sprintf("    Saved to %s", rspFilename)) + "\n")
+	}
+
+	// Save profile
+	actionStyle = ui.NormalItemStyle
+	if s.focusIndex == 5 {
+		actionStyle = ui.SelectedItemStyle
+	}
+	profileFilename := baseName + ".yaml"
+	profileText := fmt.Sprintf("Save profile (f) - %s", profileFilename)
+	b.WriteString(fmt.Sprintf("  > %s\n", actionStyle.Render(profileText)))
+
+	if s.savedProfile {
+		b.WriteString(ui.SuccessStyle.Render(fmt.Sprintf("    Saved to %s (passwords omitted)", profileFilename)) + "\n")
+	}
 	if s.saveError != "" {
 		b.WriteString(ui.ErrorStyle.Render("    " + s.saveError) + "\n")
 	}
 
 	// Quit without printing
 	actionStyle = ui.NormalItemStyle
-	if s.focusIndex == 3 {
+	if s.focusIndex == 6 {
 		actionStyle = ui.SelectedItemStyle
 	}
 	b.WriteString(fmt.Sprintf("\n  > %s\n", actionStyle.Render("Exit without printing (q)")))
@@ -232,6 +479,238 @@ func (s *SummaryStep) renderDeleteSummary() string {
 		forceDelete = "Yes"
 	}
 	b.WriteString(ui.RenderKeyValue("Force Delete", forceDelete) + "\n")
+	s.renderWallet(&b)
+
+	return b.String()
+}
+
+// renderWallet renders the wallet the SYS credentials are read from, if any
+func (s *SummaryStep) renderWallet(b *strings.Builder) {
+	if !s.config.UsesWallet() {
+		return
+	}
+	wallet := s.config.WalletLocation
+	if s.config.WalletAlias != "" {
+		wallet += " (alias " + s.config.WalletAlias + ")"
+	}
+	b.WriteString(ui.RenderKeyValue("Credentials Wallet", wallet) + "\n")
+}
+
+func (s *SummaryStep) renderCreatePDBSummary() string {
+	var b strings.Builder
+
+	b.WriteString(ui.RenderKeyValue("Operation", "CREATE PLUGGABLE DATABASE") + "\n")
+	b.WriteString(ui.RenderKeyValue("Oracle Release", string(s.config.TargetVersion)) + "\n")
+	b.WriteString(ui.RenderKeyValue("Container DB", s.config.SourceCDB) + "\n")
+	b.WriteString(ui.RenderKeyValue("New PDB", s.config.NewPDBName) + "\n")
+
+	switch s.config.PDBSource {
+	case model.PDBSourceClone:
+		b.WriteString(ui.RenderKeyValue("Source", "Clone of "+s.config.SourcePDB) + "\n")
+	case model.PDBSourceXML:
+		b.WriteString(ui.RenderKeyValue("Source", "XML "+s.config.PDBMetadataFile) + "\n")
+	default:
+		b.WriteString(ui.RenderKeyValue("Source", "PDB$SEED") + "\n")
+		b.WriteString(ui.RenderKeyValue("PDB Admin", s.config.PDBAdminUser) + "\n")
+	}
+
+	switch {
+	case s.config.PDBUseOMF && s.config.PDBDatafileDestination != "":
+		b.WriteString(ui.RenderKeyValue("Datafiles", "OMF in "+s.config.PDBDatafileDestination) + "\n")
+	case s.config.PDBUseOMF:
+		b.WriteString(ui.RenderKeyValue("Datafiles", "OMF (db_create_file_dest)") + "\n")
+	case s.config.PDBFileNameConvert != "":
+		b.WriteString(ui.RenderKeyValue("Datafiles", "Convert "+s.config.PDBFileNameConvert) + "\n")
+	default:
+		b.WriteString(ui.RenderKeyValue("Datafiles", "Locations from XML file") + "\n")
+	}
+
+	return b.String()
+}
+
+// renderConfigureSummary renders the options that differ from the current database
+func (s *SummaryStep) renderConfigureSummary() string {
+	var b strings.Builder
+
+	b.WriteString(ui.RenderKeyValue("Operation", "CONFIGURE DATABASE") + "\n")
+	b.WriteString(ui.RenderKeyValue("Database SID", s.config.ConfigureSID) + "\n")
+	s.renderWallet(&b)
+
+	baseline := s.config.Baseline()
+	options := s.config.Options()
+	changes := 0
+	change := func(key, value string) {
+		b.WriteString(ui.RenderKeyValue(key, value) + "\n")
+		changes++
+	}
+
+	if options.EnableArchiveLog != baseline.EnableArchiveLog {
+		change("Archive Log Mode", "NOARCHIVELOG -> ARCHIVELOG")
+	}
+	if options.EMChanged(baseline) {
+		em := string(options.EMConfiguration)
+		switch options.EMConfiguration {
+		case model.EMConfigDBExpress:
+			em += fmt.Sprintf(" (port %d)", options.EMPort)
+		case model.EMConfigCentral:
+			em += fmt.Sprintf(" (%s, port %d)", options.CloudControlAgent, options.EMPort)
+		}
+		change("Enterprise Manager", fmt.Sprintf("%s -> %s", baseline.EMConfiguration, em))
+	}
+	if options.EnableDataVault && !baseline.EnableDataVault {
+		change("Database Vault", "Enable (owner "+s.config.DataVaultOwner+")")
+	}
+	if options.EnableLabelSecurity && !baseline.EnableLabelSecurity {
+		change("Label Security", "Enable")
+	}
+	if changes == 0 {
+		b.WriteString(ui.RenderKeyValue("Changes", "None") + "\n")
+	}
+
+	return b.String()
+}
+
+// renderTemplateSummary renders the create template settings
+func (s *SummaryStep) renderTemplateSummary() string {
+	var b strings.Builder
+
+	b.WriteString(ui.RenderKeyValue("Operation", "CREATE TEMPLATE") + "\n")
+	b.WriteString(ui.RenderKeyValue("Oracle Release", string(s.config.TargetVersion)) + "\n")
+	b.WriteString(ui.RenderKeyValue("Source DB", s.config.TemplateSourceDB) + "\n")
+	b.WriteString(ui.RenderKeyValue("Template", s.config.NewTemplateName) + "\n")
+
+	if s.config.TemplateIncludeDatafiles {
+		b.WriteString(ui.RenderKeyValue("Contents", "Clone template with datafiles (.dbc)") + "\n")
+	} else {
+		b.WriteString(ui.RenderKeyValue("Contents", "Structure only (.dbt)") + "\n")
+	}
+
+	return b.String()
+}
+
+// renderDuplicateSummary renders the duplicate database settings
+func (s *SummaryStep) renderDuplicateSummary() string {
+	var b strings.Builder
+
+	operation := "DUPLICATE DATABASE"
+	if s.config.CreateAsStandby {
+		operation = "CREATE STANDBY DATABASE"
+	}
+	b.WriteString(ui.RenderKeyValue("Operation", operation) + "\n")
+	b.WriteString(ui.RenderKeyValue("Oracle Release", string(s.config.TargetVersion)) + "\n")
+	b.WriteString(ui.RenderKeyValue("Primary", fmt.Sprintf("%s (%s)", s.config.PrimaryConnectString, s.config.PrimaryDBName)) + "\n")
+	b.WriteString(ui.RenderKeyValue("Database Name", s.config.GlobalDBName) + "\n")
+	b.WriteString(ui.RenderKeyValue("SID", s.config.SID) + "\n")
+	if s.config.CreateAsStandby {
+		b.WriteString(ui.RenderKeyValue("DB Unique Name", s.config.StandbyUniqueName) + "\n")
+	}
+
+	if s.config.StorageType == model.StorageTypeASM {
+		b.WriteString(ui.RenderKeyValue("Storage", fmt.Sprintf("ASM (%s)", s.config.ASMDiskGroup)) + "\n")
+	} else {
+		b.WriteString(ui.RenderKeyValue("Storage", "File System") + "\n")
+		b.WriteString(ui.RenderKeyValue("Data Files", s.config.DatafileDestination) + "\n")
+	}
+
+	listener := s.config.ListenerName
+	if s.config.CreateNewListener {
+		listener = fmt.Sprintf("%s (new, port %d)", s.config.ListenerName, s.config.ListenerPort)
+	}
+	b.WriteString(ui.RenderKeyValue("Listener", listener) + "\n")
+
+	return b.String()
+}
+
+// renderInstanceSummary renders the add and delete instance settings
+func (s *SummaryStep) renderInstanceSummary(operation string) string {
+	var b strings.Builder
+
+	b.WriteString(ui.RenderKeyValue("Operation", operation) + "\n")
+	b.WriteString(ui.RenderKeyValue("Oracle Release", string(s.config.TargetVersion)) + "\n")
+	b.WriteString(ui.RenderKeyValue("Database Name", s.config.GlobalDBName) + "\n")
+	b.WriteString(ui.RenderKeyValue("Node", s.config.InstanceNode) + "\n")
+
+	instance := s.config.InstanceName
+	if instance == "" {
+		instance = "Chosen by DBCA"
+	}
+	b.WriteString(ui.RenderKeyValue("Instance", instance) + "\n")
+	if s.config.NodeList != "" {
+		b.WriteString(ui.RenderKeyValue("Cluster Nodes", s.config.NodeList) + "\n")
+	}
+	s.renderWallet(&b)
+
+	return b.String()
+}
+
+// renderPDBSummary renders the delete, unplug and plug pluggable database settings
+func (s *SummaryStep) renderPDBSummary(operation string) string {
+	var b strings.Builder
+
+	b.WriteString(ui.RenderKeyValue("Operation", operation) + "\n")
+	b.WriteString(ui.RenderKeyValue("Container DB", s.config.SourceCDB) + "\n")
+	if s.config.Operation == model.OperationPlugPDB {
+		b.WriteString(ui.RenderKeyValue("PDB", s.config.NewPDBName) + "\n")
+	} else {
+		b.WriteString(ui.RenderKeyValue("PDB", s.config.TargetPDB) + "\n")
+	}
+
+	if s.config.Operation != model.OperationDeletePDB {
+		switch s.config.PDBArchiveType {
+		case model.PDBArchiveTAR:
+			b.WriteString(ui.RenderKeyValue("Archive File", s.config.PDBArchiveFile) + "\n")
+		case model.PDBArchiveRMAN:
+			b.WriteString(ui.RenderKeyValue("RMAN Backup", s.config.PDBBackupFile) + "\n")
+			b.WriteString(ui.RenderKeyValue("XML File", s.config.PDBMetadataFile) + "\n")
+		default:
+			b.WriteString(ui.RenderKeyValue("XML File", s.config.PDBMetadataFile) + "\n")
+		}
+	}
+
+	if s.config.Operation == model.OperationPlugPDB {
+		asClone := "No"
+		if s.config.PDBCreateAsClone {
+			asClone = "Yes"
+		}
+		b.WriteString(ui.RenderKeyValue("As Clone", asClone) + "\n")
+		if s.config.PDBArchiveType == model.PDBArchiveNone {
+			copyFiles := "No (use in place)"
+			if s.config.PDBCopyFiles {
+				copyFiles = "Yes"
+			}
+			b.WriteString(ui.RenderKeyValue("Copy Datafiles", copyFiles) + "\n")
+		}
+		if s.config.PDBFileNameConvert != "" {
+			b.WriteString(ui.RenderKeyValue("File Name Convert", s.config.PDBFileNameConvert) + "\n")
+		}
+	}
+
+	auth := "SYS password"
+	if s.config.UsesOSAuthentication() {
+		auth = "Operating system"
+	}
+	b.WriteString(ui.RenderKeyValue("Authentication", auth) + "\n")
+
+	return b.String()
+}
+
+// renderRelocatePDBSummary renders the relocate pluggable database settings
+func (s *SummaryStep) renderRelocatePDBSummary() string {
+	var b strings.Builder
+
+	b.WriteString(ui.RenderKeyValue("Operation", "RELOCATE PLUGGABLE DATABASE") + "\n")
+	b.WriteString(ui.RenderKeyValue("Source CDB", s.config.RemoteCDBConnectString) + "\n")
+	b.WriteString(ui.RenderKeyValue("Source PDB", s.config.TargetPDB) + "\n")
+	b.WriteString(ui.RenderKeyValue("Target CDB", s.config.SourceCDB) + "\n")
+	b.WriteString(ui.RenderKeyValue("PDB", generator.RelocatedPDBName(s.config)) + "\n")
+	b.WriteString(ui.RenderKeyValue("Source SYSDBA User", s.config.RemoteSysDBAUser) + "\n")
+	b.WriteString(ui.RenderKeyValue("Database Link User", s.config.DBLinkUser) + "\n")
+
+	auth := "SYS password"
+	if s.config.UsesOSAuthentication() {
+		auth = "Operating system"
+	}
+	b.WriteString(ui.RenderKeyValue("Authentication", auth) + "\n")
 
 	return b.String()
 }
@@ -240,8 +719,12 @@ func (s *SummaryStep) renderCreateSummary() string {
 	var b strings.Builder
 
 	b.WriteString(ui.RenderKeyValue("Operation", "CREATE DATABASE") + "\n")
+	b.WriteString(ui.RenderKeyValue("Oracle Release", string(s.config.TargetVersion)) + "\n")
 	b.WriteString(ui.RenderKeyValue("Database Name", s.config.GlobalDBName) + "\n")
 	b.WriteString(ui.RenderKeyValue("SID", s.config.SID) + "\n")
+	if s.config.TemplateName.IsFile() {
+		b.WriteString(ui.RenderKeyValue("Template", string(s.config.TemplateName)) + "\n")
+	}
 
 	if s.config.CreateAsContainerDB {
 		b.WriteString(ui.RenderKeyValue("Container DB", "Yes") + "\n")
@@ -268,7 +751,13 @@ func (s *SummaryStep) renderCreateSummary() string {
 	}
 
 	b.WriteString(ui.RenderKeyValue("Memory", fmt.Sprintf("%d MB", s.config.TotalMemory)) + "\n")
+	if s.config.MemoryManagement == "AUTO_SGA" || s.config.MemoryManagement == "MANUAL" {
+		b.WriteString(ui.RenderKeyValue("SGA / PGA", fmt.Sprintf("%d MB / %d MB", s.config.SGASize, s.config.PGASize)) + "\n")
+	}
 	b.WriteString(ui.RenderKeyValue("Character Set", s.config.CharacterSet) + "\n")
+	if len(s.config.InitParams) > 0 {
+		b.WriteString(ui.RenderKeyValue("Init Parameters", fmt.Sprintf("%d custom", len(s.config.InitParams))) + "\n")
+	}
 
 	// Archive log mode
 	archiveMode := "NOARCHIVELOG"
@@ -276,6 +765,12 @@ func (s *SummaryStep) renderCreateSummary() string {
 		archiveMode = "ARCHIVELOG"
 	}
 	b.WriteString(ui.RenderKeyValue("Archive Mode", archiveMode) + "\n")
+	s.renderWallet(&b)
+
+	// Keys carried over from an imported response file
+	if len(s.config.ExtraResponseParams) > 0 {
+		b.WriteString(ui.RenderKeyValue("Preserved RSP Keys", fmt.Sprintf("%d (written to .rsp on save)", len(s.config.ExtraResponseParams))) + "\n")
+	}
 
 	return b.String()
 }
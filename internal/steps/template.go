@@ -1,22 +1,49 @@
 package steps
 
 import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/dbtemplate"
 	"dbca_tui/internal/model"
 	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
 	"dbca_tui/internal/wizard"
 
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 // TemplateStep handles the database template selection
 type TemplateStep struct {
-	list   ui.SelectList
-	config *model.DBConfig
+	list      ui.SelectList
+	templates []dbtemplate.Template // Templates found in the selected Oracle home
+	fileInput textinput.Model
+	phase     int // 0=template list, 1=template file
+	config    *model.DBConfig
+	err       string
 }
 
+// templateFileValue is the list value of the template file item
+const templateFileValue = "file"
+
 // NewTemplateStep creates a new template step
 func NewTemplateStep() *TemplateStep {
-	items := []ui.SelectItem{
+	s := &TemplateStep{
+		list:      ui.NewSelectList(seedTemplateItems()),
+		fileInput: textinput.New(),
+	}
+	s.fileInput.Placeholder = "/u01/app/oracle/product/19c/assistants/dbca/templates/orcl_template.dbc"
+	s.fileInput.CharLimit = 512
+
+	return s
+}
+
+// seedTemplateItems lists the templates shipped with every release, used
+// when no Oracle home was selected or its templates cannot be read
+func seedTemplateItems() []ui.SelectItem {
+	return []ui.SelectItem{
 		{
 			Title:       "General Purpose / Transaction Processing",
 			Description: "A pre-configured database template optimized for general purpose or OLTP workloads",
@@ -32,30 +59,122 @@ func NewTemplateStep() *TemplateStep {
 			Description: "Create a database with custom configuration (no template)",
 			Value:       string(model.TemplateCustom),
 		},
+		templateFileItem(),
+	}
+}
+
+// templateFileItem is the item for a template file entered by path
+func templateFileItem() ui.SelectItem {
+	return ui.SelectItem{
+		Title:       "Template File",
+		Description: "Use a template created from an existing database (.dbt or .dbc)",
+		Value:       templateFileValue,
+	}
+}
+
+// loadTemplates lists the templates of the selected Oracle home, falling
+// back to the seed templates
+func (s *TemplateStep) loadTemplates(oracleHome string) {
+	s.templates = nil
+	if oracleHome != "" {
+		s.templates, _ = dbtemplate.Scan(oracleHome)
+	}
+	if len(s.templates) == 0 {
+		s.list = ui.NewSelectList(seedTemplateItems())
+		return
+	}
+
+	var items []ui.SelectItem
+	for _, t := range s.templates {
+		title := t.File
+		if t.Name != "" {
+			title = fmt.Sprintf("%s (%s)", t.Name, t.File)
+		}
+		items = append(items, ui.SelectItem{
+			Title:       title,
+			Description: templateDescription(t),
+			Value:       t.File,
+		})
 	}
+	items = append(items,
+		ui.SelectItem{
+			Title:       "Custom Database",
+			Description: "Create a database with custom configuration (no template)",
+			Value:       string(model.TemplateCustom),
+		},
+		templateFileItem(),
+	)
+	s.list = ui.NewSelectList(items)
+}
 
-	return &TemplateStep{
-		list: ui.NewSelectList(items),
+// templateDescription summarizes the settings read from a template file
+func templateDescription(t dbtemplate.Template) string {
+	var parts []string
+	if t.Description != "" {
+		parts = append(parts, t.Description)
 	}
+	if t.IncludesDatafiles {
+		parts = append(parts, "includes datafiles")
+	}
+	if t.BlockSize > 0 {
+		parts = append(parts, fmt.Sprintf("%d KB blocks", t.BlockSize/1024))
+	}
+	if t.CharacterSet != "" {
+		parts = append(parts, t.CharacterSet)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// findTemplate returns the scanned template with the given file name
+func (s *TemplateStep) findTemplate(file string) *dbtemplate.Template {
+	for i := range s.templates {
+		if s.templates[i].File == file {
+			return &s.templates[i]
+		}
+	}
+	return nil
 }
 
 // Init initializes the step
 func (s *TemplateStep) Init(config *model.DBConfig) tea.Cmd {
 	s.config = config
-	s.list.Reset()
+	s.phase = 0
+	s.err = ""
+	s.loadTemplates(config.OracleHome)
+	s.fileInput.Blur()
 
+	selected := string(config.TemplateName)
+	if config.TemplateName.IsFile() && s.findTemplate(selected) == nil {
+		selected = templateFileValue
+	}
 	for i, item := range s.list.Items {
-		if item.Value == string(config.TemplateName) {
+		if item.Value == selected {
 			s.list.Cursor = i
 			break
 		}
 	}
 
+	// Offer the last template created from a database when no file was chosen yet
+	switch {
+	case selected == templateFileValue:
+		s.fileInput.SetValue(string(config.TemplateName))
+	case config.NewTemplateName != "" && config.TemplateIncludeDatafiles:
+		s.fileInput.SetValue(config.NewTemplateName + ".dbc")
+	case config.NewTemplateName != "":
+		s.fileInput.SetValue(config.NewTemplateName + ".dbt")
+	default:
+		s.fileInput.SetValue("")
+	}
+
 	return nil
 }
 
 // Update handles messages
 func (s *TemplateStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if s.phase == 1 {
+		return s.updateFile(msg)
+	}
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
@@ -63,6 +182,12 @@ func (s *TemplateStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.
 			return s, wizard.StepBack, nil
 		case "enter", " ":
 			s.list.Update(msg)
+			if s.list.GetSelectedValue() == templateFileValue {
+				s.phase = 1
+				s.err = ""
+				s.fileInput.Focus()
+				return s, wizard.StepStay, textinput.Blink
+			}
 			if s.list.IsSelected() {
 				return s, wizard.StepContinue, nil
 			}
@@ -74,9 +199,50 @@ func (s *TemplateStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.
 	return s, wizard.StepStay, nil
 }
 
+func (s *TemplateStep) updateFile(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc":
+			s.phase = 0
+			s.err = ""
+			s.list.Reset()
+			s.fileInput.Blur()
+			return s, wizard.StepStay, nil
+		case "enter":
+			s.err = validateStep(s, s.config, validation.Template)
+			if s.err == "" {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	s.fileInput, cmd = s.fileInput.Update(msg)
+	return s, wizard.StepStay, cmd
+}
+
 // View renders the step
 func (s *TemplateStep) View() string {
-	return ui.SubtitleStyle.Render("Select a database template:") + "\n\n" + s.list.View()
+	if s.phase == 0 {
+		return ui.SubtitleStyle.Render("Select a database template:") + "\n\n" + s.list.View()
+	}
+
+	var b strings.Builder
+
+	b.WriteString(ui.SubtitleStyle.Render("Enter the template file:") + "\n\n")
+	b.WriteString(lipgloss.JoinVertical(lipgloss.Left,
+		ui.LabelStyle.Render("Template File (absolute path, or name in the DBCA templates directory)"),
+		ui.FocusedInputStyle.Render(s.fileInput.View()),
+	) + "\n")
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue, Esc to choose another template"))
+
+	return b.String()
 }
 
 // Title returns the step title
@@ -86,7 +252,18 @@ func (s *TemplateStep) Title() string {
 
 // Apply applies the step's changes to the config
 func (s *TemplateStep) Apply(config *model.DBConfig) {
-	config.TemplateName = model.DatabaseTemplate(s.list.GetSelectedValue())
+	if value := s.list.GetSelectedValue(); value == templateFileValue {
+		config.TemplateName = model.DatabaseTemplate(strings.TrimSpace(s.fileInput.Value()))
+	} else {
+		config.TemplateName = model.DatabaseTemplate(value)
+	}
+
+	// A template of the Oracle home brings its own defaults
+	if t := s.findTemplate(string(config.TemplateName)); t != nil && s.phase == 0 {
+		t.ApplyDefaults(config)
+		return
+	}
+	dbtemplate.ClearDefaults(config)
 
 	// Set database type based on template
 	switch config.TemplateName {
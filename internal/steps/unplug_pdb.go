@@ -0,0 +1,313 @@
+package steps
+
+import (
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// UnplugPDBStep handles the unplug pluggable database configuration
+type UnplugPDBStep struct {
+	config      *model.DBConfig
+	archiveList ui.SelectList
+	inputs      []textinput.Model
+	phase       int // 0=archive type, 1=PDB and file details
+	focusIndex  int // Index into visibleFields
+	err         string
+}
+
+const (
+	upIdxCDB = iota
+	upIdxPDB
+	upIdxArchiveFile
+	upIdxBackupFile
+	upIdxXMLFile
+	upIdxSysPassword
+)
+
+// NewUnplugPDBStep creates a new unplug PDB step
+func NewUnplugPDBStep() *UnplugPDBStep {
+	archiveItems := []ui.SelectItem{
+		{
+			Title:       "TAR archive",
+			Description: "Write the XML file and datafiles into a single .tar.gz archive",
+			Value:       string(model.PDBArchiveTAR),
+		},
+		{
+			Title:       "RMAN backup",
+			Description: "Back up the PDB with RMAN and write an XML metadata file",
+			Value:       string(model.PDBArchiveRMAN),
+		},
+		{
+			Title:       "XML metadata file only",
+			Description: "Write the XML file; the datafiles stay where they are",
+			Value:       string(model.PDBArchiveNone),
+		},
+	}
+
+	s := &UnplugPDBStep{
+		archiveList: ui.NewSelectList(archiveItems),
+		inputs:      make([]textinput.Model, 6),
+	}
+
+	placeholders := []string{
+		upIdxCDB:         "orcl",
+		upIdxPDB:         "salespdb",
+		upIdxArchiveFile: "/u01/app/oracle/unplug/salespdb.tar.gz",
+		upIdxBackupFile:  "/u01/app/oracle/unplug/salespdb.bkp",
+		upIdxXMLFile:     "/u01/app/oracle/unplug/salespdb.xml",
+		upIdxSysPassword: "Empty for OS authentication",
+	}
+	limits := []int{
+		upIdxCDB:         12,
+		upIdxPDB:         30,
+		upIdxArchiveFile: 512,
+		upIdxBackupFile:  512,
+		upIdxXMLFile:     512,
+		upIdxSysPassword: 30,
+	}
+	for i := range s.inputs {
+		s.inputs[i] = textinput.New()
+		s.inputs[i].Placeholder = placeholders[i]
+		s.inputs[i].CharLimit = limits[i]
+	}
+	s.inputs[upIdxSysPassword].EchoMode = textinput.EchoPassword
+	s.inputs[upIdxSysPassword].EchoCharacter = '*'
+
+	return s
+}
+
+// Init initializes the step
+func (s *UnplugPDBStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.phase = 0
+	s.focusIndex = 0
+	s.err = ""
+	s.archiveList.Reset()
+
+	for i, item := range s.archiveList.Items {
+		if item.Value == string(config.PDBArchiveType) {
+			s.archiveList.Cursor = i
+			break
+		}
+	}
+
+	s.inputs[upIdxCDB].SetValue(config.SourceCDB)
+	s.inputs[upIdxPDB].SetValue(config.TargetPDB)
+	s.inputs[upIdxArchiveFile].SetValue(config.PDBArchiveFile)
+	s.inputs[upIdxBackupFile].SetValue(config.PDBBackupFile)
+	s.inputs[upIdxXMLFile].SetValue(config.PDBMetadataFile)
+	s.inputs[upIdxSysPassword].SetValue(config.SysPassword)
+
+	return nil
+}
+
+// Update handles messages
+func (s *UnplugPDBStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	if msg, ok := msg.(tea.KeyMsg); ok && msg.String() == "esc" {
+		if s.phase > 0 {
+			s.phase = 0
+			s.err = ""
+			s.blurAll()
+			return s, wizard.StepStay, nil
+		}
+		return s, wizard.StepBack, nil
+	}
+
+	if s.phase == 0 {
+		return s.updateArchiveType(msg)
+	}
+	return s.updateDetails(msg)
+}
+
+func (s *UnplugPDBStep) updateArchiveType(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "enter", " ":
+			s.archiveList.Update(msg)
+			if s.archiveList.IsSelected() {
+				s.phase = 1
+				s.focusIndex = 0
+				s.blurAll()
+				s.inputs[s.visibleFields()[0]].Focus()
+				return s, wizard.StepStay, textinput.Blink
+			}
+		default:
+			s.archiveList.Update(msg)
+		}
+	}
+	return s, wizard.StepStay, nil
+}
+
+func (s *UnplugPDBStep) updateDetails(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	fields := s.visibleFields()
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "tab", "down":
+			s.moveFocus(1)
+			return s, wizard.StepStay, nil
+
+		case "shift+tab", "up":
+			s.moveFocus(-1)
+			return s, wizard.StepStay, nil
+
+		case "enter":
+			if s.validate() {
+				return s, wizard.StepContinue, nil
+			}
+			return s, wizard.StepStay, nil
+		}
+	}
+
+	// Update the focused text input
+	var cmd tea.Cmd
+	idx := fields[s.focusIndex]
+	s.inputs[idx], cmd = s.inputs[idx].Update(msg)
+	return s, wizard.StepStay, cmd
+}
+
+// visibleFields returns the inputs shown for the selected archive type
+func (s *UnplugPDBStep) visibleFields() []int {
+	fields := []int{upIdxCDB, upIdxPDB}
+
+	switch s.archiveType() {
+	case model.PDBArchiveTAR:
+		fields = append(fields, upIdxArchiveFile)
+	case model.PDBArchiveRMAN:
+		fields = append(fields, upIdxBackupFile, upIdxXMLFile)
+	default:
+		fields = append(fields, upIdxXMLFile)
+	}
+
+	return append(fields, upIdxSysPassword)
+}
+
+func (s *UnplugPDBStep) archiveType() model.PDBArchiveType {
+	return model.PDBArchiveType(s.archiveList.GetSelectedValue())
+}
+
+func (s *UnplugPDBStep) moveFocus(delta int) {
+	fields := s.visibleFields()
+
+	s.blurAll()
+	s.focusIndex = (s.focusIndex + delta + len(fields)) % len(fields)
+	s.inputs[fields[s.focusIndex]].Focus()
+}
+
+func (s *UnplugPDBStep) blurAll() {
+	for i := range s.inputs {
+		s.inputs[i].Blur()
+	}
+}
+
+func (s *UnplugPDBStep) validate() bool {
+	s.err = ""
+
+	s.err = validateStep(s, s.config, validation.UnplugPDB)
+	return s.err == ""
+}
+
+// View renders the step
+func (s *UnplugPDBStep) View() string {
+	var b strings.Builder
+
+	if s.phase == 0 {
+		b.WriteString(ui.SubtitleStyle.Render("Where should the unplugged PDB be written?") + "\n\n")
+		b.WriteString(s.archiveList.View())
+		return b.String()
+	}
+
+	b.WriteString(ui.SubtitleStyle.Render("Unplug to "+s.archiveList.GetSelectedItem().Title) + "\n\n")
+
+	for i, idx := range s.visibleFields() {
+		b.WriteString(s.renderField(s.fieldLabel(idx), s.inputs[idx], s.focusIndex == i) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("The PDB is closed and removed from the container; it can be plugged in again from these files") + "\n")
+
+	if s.err != "" {
+		b.WriteString("\n" + ui.ErrorStyle.Render(s.err) + "\n")
+	}
+
+	b.WriteString("\n" + ui.SubtitleStyle.Render("Press Enter to continue"))
+
+	return b.String()
+}
+
+// fieldLabel returns the label of an input
+func (s *UnplugPDBStep) fieldLabel(idx int) string {
+	switch idx {
+	case upIdxCDB:
+		return "Container Database SID"
+	case upIdxPDB:
+		return "PDB to unplug"
+	case upIdxArchiveFile:
+		return "Archive File (.tar.gz)"
+	case upIdxBackupFile:
+		return "RMAN Backup File"
+	case upIdxXMLFile:
+		return "XML Metadata File"
+	default:
+		return "SYS Password (optional)"
+	}
+}
+
+func (s *UnplugPDBStep) renderField(label string, input textinput.Model, focused bool) string {
+	labelStyle := ui.LabelStyle
+	inputStyle := ui.InputStyle
+
+	if focused {
+		inputStyle = ui.FocusedInputStyle
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		labelStyle.Render(label),
+		inputStyle.Render(input.View()),
+	)
+}
+
+// Title returns the step title
+func (s *UnplugPDBStep) Title() string {
+	return "Unplug Pluggable Database"
+}
+
+// Apply applies the step's changes to the config
+func (s *UnplugPDBStep) Apply(config *model.DBConfig) {
+	value := func(idx int) string {
+		return strings.TrimSpace(s.inputs[idx].Value())
+	}
+
+	config.PDBArchiveType = s.archiveType()
+	config.SourceCDB = value(upIdxCDB)
+	config.TargetPDB = value(upIdxPDB)
+	config.SysPassword = s.inputs[upIdxSysPassword].Value()
+	config.UseOSAuthentication = config.SysPassword == ""
+
+	config.PDBArchiveFile = ""
+	config.PDBBackupFile = ""
+	config.PDBMetadataFile = ""
+	switch config.PDBArchiveType {
+	case model.PDBArchiveTAR:
+		config.PDBArchiveFile = value(upIdxArchiveFile)
+	case model.PDBArchiveRMAN:
+		config.PDBBackupFile = value(upIdxBackupFile)
+		config.PDBMetadataFile = value(upIdxXMLFile)
+	default:
+		config.PDBMetadataFile = value(upIdxXMLFile)
+	}
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *UnplugPDBStep) ShouldSkip(config *model.DBConfig) bool {
+	return config.Operation != model.OperationUnplugPDB
+}
@@ -0,0 +1,20 @@
+package steps
+
+import (
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/validation"
+	"dbca_tui/internal/wizard"
+)
+
+// validateStep applies the step's pending changes to a copy of the config
+// and runs the given validation section against it. It returns the message
+// of the first error, or an empty string if the step is valid.
+func validateStep(step wizard.Step, config *model.DBConfig, check func(*model.DBConfig) []validation.Finding) string {
+	pending := *config
+	step.Apply(&pending)
+
+	if f := validation.FirstError(check(&pending)); f != nil {
+		return f.Message
+	}
+	return ""
+}
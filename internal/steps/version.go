@@ -0,0 +1,116 @@
+package steps
+
+import (
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/ui"
+	"dbca_tui/internal/wizard"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// VersionStep handles the target Oracle release selection
+type VersionStep struct {
+	list   ui.SelectList
+	config *model.DBConfig
+}
+
+// NewVersionStep creates a new version step
+func NewVersionStep() *VersionStep {
+	items := []ui.SelectItem{
+		{
+			Title:       "Oracle Database 12c Release 2 (12.2)",
+			Description: "Container or non-container databases, shared or local undo",
+			Value:       string(model.Version122),
+		},
+		{
+			Title:       "Oracle Database 19c",
+			Description: "Long term release; last release supporting non-container databases",
+			Value:       string(model.Version19c),
+		},
+		{
+			Title:       "Oracle Database 21c",
+			Description: "Innovation release; container databases only",
+			Value:       string(model.Version21c),
+		},
+		{
+			Title:       "Oracle Database 23ai",
+			Description: "Long term release; container databases only, EM Express desupported",
+			Value:       string(model.Version23ai),
+		},
+	}
+
+	return &VersionStep{
+		list: ui.NewSelectList(items),
+	}
+}
+
+// Init initializes the step
+func (s *VersionStep) Init(config *model.DBConfig) tea.Cmd {
+	s.config = config
+	s.list.Reset()
+
+	for i, item := range s.list.Items {
+		if item.Value == string(config.TargetVersion) {
+			s.list.Cursor = i
+			break
+		}
+	}
+
+	return nil
+}
+
+// Update handles messages
+func (s *VersionStep) Update(msg tea.Msg) (wizard.Step, wizard.StepResult, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc":
+			return s, wizard.StepBack, nil
+		case "enter", " ":
+			s.list.Update(msg)
+			if s.list.IsSelected() {
+				return s, wizard.StepContinue, nil
+			}
+		default:
+			s.list.Update(msg)
+		}
+	}
+
+	return s, wizard.StepStay, nil
+}
+
+// View renders the step
+func (s *VersionStep) View() string {
+	return ui.SubtitleStyle.Render("Select the target Oracle Database release:") + "\n\n" + s.list.View()
+}
+
+// Title returns the step title
+func (s *VersionStep) Title() string {
+	return "Oracle Release"
+}
+
+// Apply applies the step's changes to the config
+func (s *VersionStep) Apply(config *model.DBConfig) {
+	config.TargetVersion = model.OracleVersion(s.list.GetSelectedValue())
+
+	// Later releases cannot create non-container databases
+	if !config.TargetVersion.SupportsNonCDB() && !config.CreateAsContainerDB {
+		config.CreateAsContainerDB = true
+		if config.NumberOfPDBs == 0 {
+			config.NumberOfPDBs = 1
+		}
+	}
+	if !config.TargetVersion.SupportsEMExpress() && config.EMConfiguration == model.EMConfigDBExpress {
+		config.EMConfiguration = model.EMConfigNone
+	}
+}
+
+// ShouldSkip returns whether this step should be skipped
+func (s *VersionStep) ShouldSkip(config *model.DBConfig) bool {
+	switch config.Operation {
+	case model.OperationCreate, model.OperationConfigure, model.OperationTemplate, model.OperationDuplicate:
+		return false
+	default:
+		return !config.Operation.IsPluggable() && !config.Operation.IsInstance()
+	}
+}
@@ -1,6 +1,10 @@
 package ui
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+)
 
 var (
 	// Colors
@@ -10,6 +14,7 @@ var (
 	TextColor      = lipgloss.Color("#FAFAFA")
 	MutedColor     = lipgloss.Color("#888888")
 	ErrorColor     = lipgloss.Color("#FF5555")
+	WarningColor   = lipgloss.Color("#FFB86C")
 	SuccessColor   = lipgloss.Color("#55FF55")
 
 	// Title style
@@ -64,6 +69,11 @@ var (
 			Foreground(ErrorColor).
 			Bold(true)
 
+	// Warning style
+	WarningStyle = lipgloss.NewStyle().
+			Foreground(WarningColor).
+			Bold(true)
+
 	// Success style
 	SuccessStyle = lipgloss.NewStyle().
 			Foreground(SuccessColor).
@@ -115,9 +125,9 @@ func RenderHeader(title string, step int, totalSteps int) string {
 	stepText := StepIndicatorStyle.Render(
 		lipgloss.JoinHorizontal(lipgloss.Left,
 			"Step ",
-			lipgloss.NewStyle().Bold(true).Render(string(rune('0'+step))),
+			lipgloss.NewStyle().Bold(true).Render(strconv.Itoa(step)),
 			" of ",
-			lipgloss.NewStyle().Render(string(rune('0'+totalSteps))),
+			lipgloss.NewStyle().Render(strconv.Itoa(totalSteps)),
 		),
 	)
 
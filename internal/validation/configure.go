@@ -0,0 +1,89 @@
+package validation
+
+import (
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// ConfigureDatabase validates the changes requested for an existing database
+func ConfigureDatabase(config *model.DBConfig) []Finding {
+	var fs findings
+
+	fs = append(fs, ConfigureSource(config)...)
+	fs = append(fs, ConfigureArchiveLog(config)...)
+	if config.Options().EMChanged(config.Baseline()) {
+		fs = append(fs, Management(config)...)
+	}
+	fs = append(fs, ConfigureSecurity(config)...)
+
+	if config.Options() == config.Baseline() {
+		fs.warning("CFG-NO-CHANGES", "Operation", "No option differs from the current database; the command changes nothing")
+	}
+
+	return fs
+}
+
+// ConfigureSource validates the SID of the database to configure and the wallet it is reached with
+func ConfigureSource(config *model.DBConfig) []Finding {
+	var fs findings
+
+	sid := strings.TrimSpace(config.ConfigureSID)
+	if sid == "" {
+		fs.error("CFG-SID-REQUIRED", "ConfigureSID", "Database SID is required")
+	} else if msg := checkSID(sid); msg != "" {
+		fs.error("CFG-SID-FORMAT", "ConfigureSID", msg)
+	}
+
+	checkSysDBA(config, &fs, "CFG-SYS-REQUIRED")
+
+	return fs
+}
+
+// ConfigureArchiveLog validates the archive log mode change of an existing database
+func ConfigureArchiveLog(config *model.DBConfig) []Finding {
+	var fs findings
+
+	// DBCA only switches archiving on
+	if config.Baseline().EnableArchiveLog && !config.EnableArchiveLog {
+		fs.error("CFG-ARCHIVE-DISABLE", "EnableArchiveLog",
+			"DBCA cannot switch a database back to NOARCHIVELOG mode; use ALTER DATABASE NOARCHIVELOG")
+	}
+
+	return fs
+}
+
+// ConfigureSecurity validates the Database Vault and Label Security changes
+// of an existing database
+func ConfigureSecurity(config *model.DBConfig) []Finding {
+	var fs findings
+
+	baseline := config.Baseline()
+
+	// DBCA only switches these options on
+	if baseline.EnableDataVault && !config.EnableDataVault {
+		fs.error("CFG-DV-DISABLE", "EnableDataVault", "DBCA cannot disable Database Vault; use DBMS_MACADM.DISABLE_DV")
+	}
+	if baseline.EnableLabelSecurity && !config.EnableLabelSecurity {
+		fs.error("CFG-OLS-DISABLE", "EnableLabelSecurity", "DBCA cannot disable Label Security; use LBACSYS.CONFIGURE_OLS")
+	}
+
+	// Newly enabled Database Vault needs its accounts and their passwords
+	if config.EnableDataVault && !baseline.EnableDataVault {
+		fs = append(fs, DataVault(config)...)
+		if config.DataVaultOwnerPassword == "" {
+			fs.error("CFG-DV-OWNER-PASSWORD-REQUIRED", "DataVaultOwnerPassword", "Data Vault Owner password is required")
+		}
+		if config.DataVaultAccountManagerPassword == "" {
+			fs.error("CFG-DV-ACCTMGR-PASSWORD-REQUIRED", "DataVaultAccountManagerPassword",
+				"Data Vault Account Manager password is required")
+		}
+		checkPasswordPolicy(config, &fs)
+		checkNewPassword(config, &fs, "CFG-DV-OWNER-PASSWORD-POLICY", "DataVaultOwnerPassword",
+			"Data Vault Owner password", config.DataVaultOwnerPassword, config.DataVaultOwner)
+		checkNewPassword(config, &fs, "CFG-DV-ACCTMGR-PASSWORD-POLICY", "DataVaultAccountManagerPassword",
+			"Data Vault Account Manager password", config.DataVaultAccountManagerPassword, config.DataVaultAccountManager)
+	}
+
+	return fs
+}
@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// DuplicateDatabase validates the duplicate database operation
+func DuplicateDatabase(config *model.DBConfig) []Finding {
+	var fs findings
+
+	fs = append(fs, Release(config)...)
+	fs = append(fs, DuplicateSource(config)...)
+	fs = append(fs, Storage(config)...)
+	fs = append(fs, Network(config)...)
+
+	return fs
+}
+
+// DuplicateSource validates the primary database, the name of the duplicate
+// and, for a standby, its db_unique_name
+func DuplicateSource(config *model.DBConfig) []Finding {
+	var fs findings
+
+	primary := strings.TrimSpace(config.PrimaryConnectString)
+	if primary == "" {
+		fs.error("DUP-PRIMARY-REQUIRED", "PrimaryConnectString", "Primary connect string is required")
+	} else if !isEZConnect(primary) {
+		fs.error("DUP-PRIMARY-FORMAT", "PrimaryConnectString",
+			"Primary connect string must be an EZCONNECT string such as host:1521/service")
+	} else if msg := checkEZConnectPort(primary); msg != "" {
+		fs.error("DUP-PRIMARY-FORMAT", "PrimaryConnectString", msg)
+	}
+
+	primaryName := strings.TrimSpace(config.PrimaryDBName)
+	if primaryName == "" {
+		fs.error("DUP-PRIMARY-DBNAME-REQUIRED", "PrimaryDBName", "Primary DB_NAME is required")
+	} else if msg := checkDBName(primaryName); msg != "" {
+		fs.error("DUP-PRIMARY-DBNAME-FORMAT", "PrimaryDBName", msg)
+	}
+
+	checkGlobalNameAndSID(config, &fs)
+
+	// The files are copied over the network, so OS authentication does not apply
+	if config.SysPassword == "" {
+		fs.error("DUP-SYS-PASSWORD-REQUIRED", "SysPassword", "SYS password of the primary database is required")
+	}
+	checkExistingPassword(&fs, "SysPassword", "SYS password", config.SysPassword)
+
+	if config.CreateAsStandby {
+		// A physical standby is a copy of the primary and keeps its DB_NAME
+		dbName, _ := SplitGlobalName(config.GlobalDBName)
+		if primaryName != "" && dbName != "" && !strings.EqualFold(dbName, primaryName) {
+			fs.error("DUP-GDBNAME-MISMATCH", "GlobalDBName",
+				fmt.Sprintf("DB_NAME %q of the standby must match the primary DB_NAME %q", dbName, primaryName))
+		}
+
+		uniqueName := strings.TrimSpace(config.StandbyUniqueName)
+		if uniqueName == "" {
+			fs.error("DUP-UNIQUE-NAME-REQUIRED", "StandbyUniqueName", "Standby DB_UNIQUE_NAME is required")
+		} else if msg := checkName("DB_UNIQUE_NAME", uniqueName, maxUniqueNameLength, dbNameSpecials); msg != "" {
+			fs.error("DUP-UNIQUE-NAME-FORMAT", "StandbyUniqueName", msg)
+		} else if strings.EqualFold(uniqueName, primaryName) {
+			fs.warning("DUP-UNIQUE-NAME-PRIMARY", "StandbyUniqueName",
+				"DB_UNIQUE_NAME matches the primary DB_NAME, which the primary usually uses as its DB_UNIQUE_NAME")
+		}
+	}
+
+	return fs
+}
@@ -0,0 +1,70 @@
+package validation
+
+import (
+	"slices"
+	"testing"
+
+	"dbca_tui/internal/model"
+)
+
+func TestDuplicateSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		edit     func(c *model.DBConfig)
+		want     []string
+		warnings []string
+	}{
+		{"valid", func(c *model.DBConfig) {}, nil, nil},
+		{"missing primary", func(c *model.DBConfig) { c.PrimaryConnectString = "" }, []string{"DUP-PRIMARY-REQUIRED"}, nil},
+		{"primary with spaces", func(c *model.DBConfig) { c.PrimaryConnectString = "host1 /sales" }, []string{"DUP-PRIMARY-FORMAT"}, nil},
+		{"primary port", func(c *model.DBConfig) { c.PrimaryConnectString = "host1:0/sales" }, []string{"DUP-PRIMARY-FORMAT"}, nil},
+		{"missing primary DB_NAME", func(c *model.DBConfig) { c.PrimaryDBName = "" }, []string{"DUP-PRIMARY-DBNAME-REQUIRED"}, nil},
+		{"primary DB_NAME format", func(c *model.DBConfig) { c.PrimaryDBName = "salesprimary" }, []string{"DUP-PRIMARY-DBNAME-FORMAT"}, nil},
+		{"duplicate SID", func(c *model.DBConfig) { c.SID = "sales-2" }, []string{"ID-SID-FORMAT"}, nil},
+		{"missing SYS password", func(c *model.DBConfig) { c.SysPassword = "" }, []string{"DUP-SYS-PASSWORD-REQUIRED"}, nil},
+		{"no operating system authentication", func(c *model.DBConfig) {
+			c.SysPassword = ""
+			c.UseOSAuthentication = true
+		}, []string{"DUP-SYS-PASSWORD-REQUIRED"}, nil},
+		{"standby", func(c *model.DBConfig) {
+			c.CreateAsStandby = true
+			c.GlobalDBName = "sales.example.com"
+		}, nil, nil},
+		{"standby DB_NAME mismatch", func(c *model.DBConfig) { c.CreateAsStandby = true }, []string{"DUP-GDBNAME-MISMATCH"}, nil},
+		{"standby without unique name", func(c *model.DBConfig) {
+			c.CreateAsStandby = true
+			c.GlobalDBName = "sales"
+			c.StandbyUniqueName = ""
+		}, []string{"DUP-UNIQUE-NAME-REQUIRED"}, nil},
+		{"standby unique name format", func(c *model.DBConfig) {
+			c.CreateAsStandby = true
+			c.GlobalDBName = "sales"
+			c.StandbyUniqueName = "sales-stby"
+		}, []string{"DUP-UNIQUE-NAME-FORMAT"}, nil},
+		{"standby unique name of the primary", func(c *model.DBConfig) {
+			c.CreateAsStandby = true
+			c.GlobalDBName = "sales"
+			c.StandbyUniqueName = "SALES"
+		}, nil, []string{"DUP-UNIQUE-NAME-PRIMARY"}},
+	}
+
+	for _, tt := range tests {
+		config := model.NewDBConfig()
+		config.Operation = model.OperationDuplicate
+		config.PrimaryConnectString = "host1:1521/sales"
+		config.PrimaryDBName = "sales"
+		config.GlobalDBName = "salesdup.example.com"
+		config.SID = "salesdup"
+		config.StandbyUniqueName = "sales_stby"
+		config.SysPassword = "Kx7_pq2m"
+		tt.edit(config)
+
+		findings := DuplicateSource(config)
+		if got := ruleIDs(findings, SeverityError); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: DuplicateSource() errors = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := ruleIDs(findings, SeverityWarning); !slices.Equal(got, tt.warnings) {
+			t.Errorf("%s: DuplicateSource() warnings = %v, want %v", tt.name, got, tt.warnings)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package validation
+
+import (
+	"fmt"
+
+	"dbca_tui/internal/hostprobe"
+	"dbca_tui/internal/model"
+)
+
+// Host checks the memory settings against the resources of the host the
+// database will run on. It returns no findings if info is nil.
+func Host(config *model.DBConfig, info *hostprobe.HostInfo) []Finding {
+	var fs findings
+	if info == nil {
+		return fs
+	}
+
+	if config.TotalMemory > info.TotalMemoryMB {
+		fs.warning("HOST-MEMORY-EXCEEDED", "TotalMemory",
+			fmt.Sprintf("Total memory (%d MB) exceeds the host's physical memory (%d MB)", config.TotalMemory, info.TotalMemoryMB))
+	}
+
+	if info.HugePagesConfigured() {
+		switch config.MemoryManagement {
+		case "AUTO":
+			fs.warning("HOST-AMM-HUGEPAGES", "MemoryManagement",
+				fmt.Sprintf("HugePages are configured (%d MB) but Automatic Memory Management cannot use them; choose Automatic Shared Memory Management", info.HugePagesMB()))
+		case "AUTO_SGA", "MANUAL":
+			if config.SGASize > info.HugePagesMB() {
+				fs.warning("HOST-SGA-HUGEPAGES", "SGASize",
+					fmt.Sprintf("SGA (%d MB) is larger than the HugePages pool (%d MB); the remainder uses regular pages", config.SGASize, info.HugePagesMB()))
+			}
+		}
+	}
+
+	return fs
+}
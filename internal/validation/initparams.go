@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// initParamNamePattern matches documented and underscore (hidden) parameter names
+var initParamNamePattern = regexp.MustCompile(`^_{0,2}[a-z][a-z0-9_$#]*$`)
+
+// wizardManagedParams are set from other wizard fields and must not be overridden
+var wizardManagedParams = map[string]string{
+	"db_name":   "Global Database Name",
+	"db_domain": "Global Database Name",
+}
+
+// memoryParams conflict with the memory management chosen in Configuration Options
+var memoryParams = map[string]bool{
+	"memory_target":     true,
+	"memory_max_target": true,
+}
+
+// sizingParams are generated from the SGA/PGA sizes for AUTO_SGA and MANUAL
+var sizingParams = map[string]bool{
+	"sga_target":           true,
+	"sga_max_size":         true,
+	"pga_aggregate_target": true,
+	"pga_aggregate_limit":  true,
+}
+
+// CheckInitParam validates a single initialization parameter and returns
+// an empty string if it is acceptable
+func CheckInitParam(name, value string) string {
+	if name == "" {
+		return "Parameter name is required"
+	}
+	if !initParamNamePattern.MatchString(name) {
+		return fmt.Sprintf("Parameter name %q may only contain letters, digits, _, $ and # and must start with a letter", name)
+	}
+	if source, ok := wizardManagedParams[name]; ok {
+		return fmt.Sprintf("%s is set from the %s; change it there instead", name, source)
+	}
+	if strings.TrimSpace(value) == "" {
+		return fmt.Sprintf("Value for %s is required", name)
+	}
+	// A list value is quoted in the initParams option, which needs a quote character it does not contain
+	if strings.Contains(value, ",") && strings.Contains(value, "'") && strings.Contains(value, `"`) {
+		return fmt.Sprintf("Value for %s must not contain both ' and \" when it lists several values", name)
+	}
+
+	if info, ok := model.LookupInitParam(name); ok && info.Numeric {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return fmt.Sprintf("%s must be a positive integer", name)
+		}
+	}
+
+	if name == "db_block_size" {
+		switch value {
+		case "2048", "4096", "8192", "16384", "32768":
+		default:
+			return "db_block_size must be 2048, 4096, 8192, 16384 or 32768"
+		}
+	}
+
+	return ""
+}
+
+// InitParameters validates the custom initialization parameters
+func InitParameters(config *model.DBConfig) []Finding {
+	var fs findings
+
+	names := make([]string, 0, len(config.InitParams))
+	for name := range config.InitParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := config.InitParams[name]
+		if msg := CheckInitParam(name, value); msg != "" {
+			fs.error("INIT-PARAM-INVALID", "InitParams", msg)
+			continue
+		}
+		if sizingParams[name] && config.MemoryManagement != "AUTO" {
+			fs.error("INIT-MEMORY-MANAGED", "InitParams",
+				fmt.Sprintf("%s is set from the SGA/PGA sizes in Configuration Options; remove it here", name))
+		}
+		if memoryParams[name] && config.MemoryManagement != "AUTO" {
+			fs.warning("INIT-MEMORY-CONFLICT", "InitParams",
+				fmt.Sprintf("%s enables Automatic Memory Management, which conflicts with the selected %s memory management", name, config.MemoryManagement))
+		}
+	}
+
+	return fs
+}
@@ -0,0 +1,73 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// Instance validates the add and delete instance operations
+func Instance(config *model.DBConfig) []Finding {
+	var fs findings
+
+	fs = append(fs, Release(config)...)
+
+	dbName, dbDomain := SplitGlobalName(config.GlobalDBName)
+	if strings.TrimSpace(config.GlobalDBName) == "" {
+		fs.error("INST-GDBNAME-REQUIRED", "GlobalDBName", "Global Database Name of the RAC database is required")
+	} else {
+		if msg := checkDBName(dbName); msg != "" {
+			fs.error("INST-DBNAME-FORMAT", "GlobalDBName", msg)
+		}
+		if msg := checkDBDomain(dbDomain); msg != "" {
+			fs.error("INST-DBDOMAIN-FORMAT", "GlobalDBName", msg)
+		}
+	}
+
+	node := strings.TrimSpace(config.InstanceNode)
+	nodes := SplitNodeList(config.NodeList)
+	if node == "" {
+		fs.error("INST-NODE-REQUIRED", "InstanceNode", "Node name is required")
+	} else if strings.ContainsAny(node, ", \t") {
+		fs.error("INST-NODE-FORMAT", "InstanceNode", "Node name must be a single host name")
+	} else if len(nodes) > 0 && !containsFold(nodes, node) {
+		fs.error("INST-NODE-NOT-IN-CLUSTER", "InstanceNode",
+			fmt.Sprintf("Node %q is not one of the cluster nodes %s", node, strings.Join(nodes, ", ")))
+	}
+
+	// DBCA names a new instance itself; deleting needs to know which one
+	name := strings.TrimSpace(config.InstanceName)
+	if name == "" {
+		if config.Operation == model.OperationDeleteInstance {
+			fs.error("INST-NAME-REQUIRED", "InstanceName", "Instance name is required")
+		}
+	} else if msg := checkSID(name); msg != "" {
+		fs.error("INST-NAME-FORMAT", "InstanceName", msg)
+	}
+
+	checkSysDBA(config, &fs, "INST-SYS-REQUIRED")
+
+	return fs
+}
+
+// SplitNodeList splits a comma separated cluster node list, dropping empty entries
+func SplitNodeList(value string) []string {
+	var nodes []string
+	for _, n := range strings.Split(value, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			nodes = append(nodes, n)
+		}
+	}
+	return nodes
+}
+
+// containsFold returns true if the list holds the value, ignoring case
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
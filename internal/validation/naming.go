@@ -0,0 +1,166 @@
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxDBNameLength     = 8
+	maxSIDLength        = 12
+	maxDomainLength     = 128
+	maxPDBNameLength    = 30
+	maxUniqueNameLength = 30
+	dbNameSpecials      = "_$#"
+	sidSpecials         = "_"
+	domainSpecials      = "_#"
+	pdbNameSpecials     = "_$#"
+	reservedPDBPrefix   = "PDB$"
+)
+
+// reservedWords are the Oracle SQL reserved words (V$RESERVED_WORDS with
+// RESERVED = 'Y') that cannot be used as database, SID or PDB names
+var reservedWords = map[string]bool{
+	"ACCESS": true, "ADD": true, "ALL": true, "ALTER": true, "AND": true,
+	"ANY": true, "AS": true, "ASC": true, "AUDIT": true, "BETWEEN": true,
+	"BY": true, "CHAR": true, "CHECK": true, "CLUSTER": true, "COLUMN": true,
+	"COMMENT": true, "COMPRESS": true, "CONNECT": true, "CREATE": true, "CURRENT": true,
+	"DATE": true, "DECIMAL": true, "DEFAULT": true, "DELETE": true, "DESC": true,
+	"DISTINCT": true, "DROP": true, "ELSE": true, "EXCLUSIVE": true, "EXISTS": true,
+	"FILE": true, "FLOAT": true, "FOR": true, "FROM": true, "GRANT": true,
+	"GROUP": true, "HAVING": true, "IDENTIFIED": true, "IMMEDIATE": true, "IN": true,
+	"INCREMENT": true, "INDEX": true, "INITIAL": true, "INSERT": true, "INTEGER": true,
+	"INTERSECT": true, "INTO": true, "IS": true, "LEVEL": true, "LIKE": true,
+	"LOCK": true, "LONG": true, "MAXEXTENTS": true, "MINUS": true, "MLSLABEL": true,
+	"MODE": true, "MODIFY": true, "NOAUDIT": true, "NOCOMPRESS": true, "NOT": true,
+	"NOWAIT": true, "NULL": true, "NUMBER": true, "OF": true, "OFFLINE": true,
+	"ON": true, "ONLINE": true, "OPTION": true, "OR": true, "ORDER": true,
+	"PCTFREE": true, "PRIOR": true, "PUBLIC": true, "RAW": true, "RENAME": true,
+	"RESOURCE": true, "REVOKE": true, "ROW": true, "ROWID": true, "ROWNUM": true,
+	"ROWS": true, "SELECT": true, "SESSION": true, "SET": true, "SHARE": true,
+	"SIZE": true, "SMALLINT": true, "START": true, "SUCCESSFUL": true, "SYNONYM": true,
+	"SYSDATE": true, "TABLE": true, "THEN": true, "TO": true, "TRIGGER": true,
+	"UID": true, "UNION": true, "UNIQUE": true, "UPDATE": true, "USER": true,
+	"VALIDATE": true, "VALUES": true, "VARCHAR": true, "VARCHAR2": true, "VIEW": true,
+	"WHENEVER": true, "WHERE": true, "WITH": true,
+}
+
+// SplitGlobalName splits a global database name into DB_NAME and DB_DOMAIN.
+// The domain is everything after the first period.
+func SplitGlobalName(globalName string) (dbName, dbDomain string) {
+	dbName, dbDomain, _ = strings.Cut(strings.TrimSpace(globalName), ".")
+	return dbName, dbDomain
+}
+
+// IsReservedWord returns true if the name is an Oracle SQL reserved word
+func IsReservedWord(name string) bool {
+	return reservedWords[strings.ToUpper(name)]
+}
+
+// checkName applies the rules shared by all Oracle identifiers used here:
+// a maximum length, a leading letter, a restricted character set and no
+// reserved words. It returns an empty string if the name is valid.
+func checkName(label, name string, maxLen int, specials string) string {
+	if len(name) > maxLen {
+		return fmt.Sprintf("%s %q is %d characters long; the maximum is %d", label, name, len(name), maxLen)
+	}
+	if !isASCIILetter(rune(name[0])) {
+		return fmt.Sprintf("%s %q must start with a letter", label, name)
+	}
+	for _, r := range name {
+		if !isASCIILetter(r) && !isDigit(r) && !strings.ContainsRune(specials, r) {
+			return fmt.Sprintf("%s %q contains invalid character %q; only letters, digits and %s are allowed",
+				label, name, r, describeSpecials(specials))
+		}
+	}
+	if IsReservedWord(name) {
+		return fmt.Sprintf("%s %q is an Oracle reserved word", label, name)
+	}
+	return ""
+}
+
+// checkDBName validates the DB_NAME part of a global database name
+func checkDBName(dbName string) string {
+	if dbName == "" {
+		return "DB_NAME (the part of the Global Database Name before the first period) must not be empty"
+	}
+	return checkName("DB_NAME", dbName, maxDBNameLength, dbNameSpecials)
+}
+
+// checkDBDomain validates the DB_DOMAIN part of a global database name
+func checkDBDomain(domain string) string {
+	if domain == "" {
+		return ""
+	}
+	if len(domain) > maxDomainLength {
+		return fmt.Sprintf("DB_DOMAIN %q is %d characters long; the maximum is %d", domain, len(domain), maxDomainLength)
+	}
+	for _, component := range strings.Split(domain, ".") {
+		if component == "" {
+			return fmt.Sprintf("DB_DOMAIN %q contains an empty component (consecutive or trailing periods)", domain)
+		}
+		if !isASCIILetter(rune(component[0])) {
+			return fmt.Sprintf("DB_DOMAIN component %q must start with a letter", component)
+		}
+		for _, r := range component {
+			if !isASCIILetter(r) && !isDigit(r) && !strings.ContainsRune(domainSpecials, r) {
+				return fmt.Sprintf("DB_DOMAIN component %q contains invalid character %q; only letters, digits and %s are allowed",
+					component, r, describeSpecials(domainSpecials))
+			}
+		}
+	}
+	return ""
+}
+
+// checkSID validates an Oracle SID
+func checkSID(sid string) string {
+	return checkName("SID", sid, maxSIDLength, sidSpecials)
+}
+
+// checkPDBName validates a PDB name or, when creating several PDBs, the
+// prefix DBCA appends a sequence number to
+func checkPDBName(pdbName, dbName string, count int) string {
+	maxLen := maxPDBNameLength
+	label := "PDB name"
+	if count > 1 {
+		maxLen -= len(strconv.Itoa(count))
+		label = "PDB name prefix"
+	}
+
+	if msg := checkName(label, pdbName, maxLen, pdbNameSpecials); msg != "" {
+		if count > 1 && len(pdbName) > maxLen {
+			msg += fmt.Sprintf(" (DBCA appends up to %d digits)", len(strconv.Itoa(count)))
+		}
+		return msg
+	}
+
+	upper := strings.ToUpper(pdbName)
+	if strings.HasPrefix(upper, reservedPDBPrefix) || upper == "CDB$ROOT" {
+		return fmt.Sprintf("%s %q is reserved for Oracle-maintained containers", label, pdbName)
+	}
+	if dbName != "" && strings.EqualFold(pdbName, dbName) {
+		return fmt.Sprintf("%s %q must differ from the DB_NAME, otherwise their services collide", label, pdbName)
+	}
+	return ""
+}
+
+func isASCIILetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// describeSpecials renders the allowed special characters for messages
+func describeSpecials(specials string) string {
+	parts := make([]string, 0, len(specials))
+	for _, r := range specials {
+		parts = append(parts, string(r))
+	}
+	if len(parts) == 1 {
+		return parts[0]
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
@@ -0,0 +1,195 @@
+package validation
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"dbca_tui/internal/model"
+)
+
+func TestSplitGlobalName(t *testing.T) {
+	tests := []struct {
+		globalName   string
+		wantDBName   string
+		wantDBDomain string
+	}{
+		{"orcl", "orcl", ""},
+		{"sales.example.com", "sales", "example.com"},
+		{"  sales.example.com ", "sales", "example.com"},
+		{"sales.", "sales", ""},
+		{".example.com", "", "example.com"},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		dbName, dbDomain := SplitGlobalName(tt.globalName)
+		if dbName != tt.wantDBName || dbDomain != tt.wantDBDomain {
+			t.Errorf("SplitGlobalName(%q) = %q, %q, want %q, %q", tt.globalName, dbName, dbDomain, tt.wantDBName, tt.wantDBDomain)
+		}
+	}
+}
+
+func TestIsReservedWord(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"SELECT", true},
+		{"select", true},
+		{"Rowid", true},
+		{"VARCHAR2", true},
+		{"orcl", false},
+		{"SELECTS", false},
+	}
+
+	for _, tt := range tests {
+		if got := IsReservedWord(tt.name); got != tt.want {
+			t.Errorf("IsReservedWord(%q) = %t, want %t", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheckName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string // Substring of the message; empty if the name is valid
+	}{
+		{"orcl", ""},
+		{"a", ""},
+		{"o_r$c#1", ""},
+		{"orcl12345", "is 9 characters long; the maximum is 8"},
+		{"1orcl", "must start with a letter"},
+		{"_orcl", "must start with a letter"},
+		{"or-cl", `contains invalid character '-'; only letters, digits and _, $ and # are allowed`},
+		{"orçl", "contains invalid character 'ç'"},
+		{"TABLE", "is an Oracle reserved word"},
+	}
+
+	for _, tt := range tests {
+		got := checkName("DB_NAME", tt.name, maxDBNameLength, dbNameSpecials)
+		if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+			t.Errorf("checkName(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheckSID(t *testing.T) {
+	tests := []struct {
+		sid  string
+		want string
+	}{
+		{"orcl", ""},
+		{"orcl_1", ""},
+		{"abcdefghijkl", ""},
+		{"abcdefghijklm", "is 13 characters long; the maximum is 12"},
+		{"orcl$1", `contains invalid character '$'; only letters, digits and _ are allowed`},
+		{"orcl#1", "contains invalid character '#'"},
+		{"9orcl", "must start with a letter"},
+		{"user", "is an Oracle reserved word"},
+	}
+
+	for _, tt := range tests {
+		got := checkSID(tt.sid)
+		if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+			t.Errorf("checkSID(%q) = %q, want %q", tt.sid, got, tt.want)
+		}
+	}
+}
+
+func TestCheckDBName(t *testing.T) {
+	tests := []struct {
+		dbName string
+		want   string
+	}{
+		{"orcl", ""},
+		{"abcdefgh", ""},
+		{"", "must not be empty"},
+		{"abcdefghi", "is 9 characters long; the maximum is 8"},
+		{"or-cl", "contains invalid character '-'"},
+	}
+
+	for _, tt := range tests {
+		got := checkDBName(tt.dbName)
+		if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+			t.Errorf("checkDBName(%q) = %q, want %q", tt.dbName, got, tt.want)
+		}
+	}
+}
+
+func TestCheckDBDomain(t *testing.T) {
+	tests := []struct {
+		domain string
+		want   string
+	}{
+		{"", ""},
+		{"example.com", ""},
+		{"dev_1.example#corp.com", ""},
+		{"example..com", "contains an empty component"},
+		{"example.com.", "contains an empty component"},
+		{"example.1com", `component "1com" must start with a letter`},
+		{"my-company.com", `component "my-company" contains invalid character '-'; only letters, digits and _ and # are allowed`},
+		{strings.Repeat("a", 129), "is 129 characters long; the maximum is 128"},
+	}
+
+	for _, tt := range tests {
+		got := checkDBDomain(tt.domain)
+		if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+			t.Errorf("checkDBDomain(%q) = %q, want %q", tt.domain, got, tt.want)
+		}
+	}
+}
+
+func TestCheckPDBName(t *testing.T) {
+	tests := []struct {
+		pdbName string
+		dbName  string
+		count   int
+		want    string
+	}{
+		{"salespdb", "sales", 1, ""},
+		{"sales$pdb#1", "", 1, ""},
+		{strings.Repeat("p", 30), "", 1, ""},
+		{strings.Repeat("p", 31), "", 1, "PDB name"},
+		{strings.Repeat("p", 29), "", 5, ""},
+		{strings.Repeat("p", 29), "", 10, "PDB name prefix \"" + strings.Repeat("p", 29) + "\" is 29 characters long; the maximum is 28 (DBCA appends up to 2 digits)"},
+		{"PDB$SEED", "", 1, "is reserved for Oracle-maintained containers"},
+		{"cdb$root", "", 1, "is reserved for Oracle-maintained containers"},
+		{"SALES", "sales", 1, "must differ from the DB_NAME"},
+		{"pdb-1", "", 1, "contains invalid character '-'"},
+	}
+
+	for _, tt := range tests {
+		got := checkPDBName(tt.pdbName, tt.dbName, tt.count)
+		if (tt.want == "") != (got == "") || !strings.Contains(got, tt.want) {
+			t.Errorf("checkPDBName(%q, %q, %d) = %q, want %q", tt.pdbName, tt.dbName, tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestIdentification(t *testing.T) {
+	tests := []struct {
+		name       string
+		globalName string
+		sid        string
+		want       []string
+	}{
+		{"valid", "sales.example.com", "sales", nil},
+		{"missing", " ", "", []string{"ID-GDBNAME-REQUIRED", "ID-SID-REQUIRED"}},
+		{"long DB_NAME", "salesreport.example.com", "sales", []string{"ID-DBNAME-FORMAT"}},
+		{"bad domain part", "sales.example..com", "sales", []string{"ID-DBDOMAIN-FORMAT"}},
+		{"bad DB_NAME and domain", "9sales.my-corp", "sales", []string{"ID-DBNAME-FORMAT", "ID-DBDOMAIN-FORMAT"}},
+		{"long SID", "sales", "salesreport01", []string{"ID-SID-FORMAT"}},
+		{"reserved SID", "sales", "ORDER", []string{"ID-SID-FORMAT"}},
+	}
+
+	for _, tt := range tests {
+		config := model.NewDBConfig()
+		config.GlobalDBName = tt.globalName
+		config.SID = tt.sid
+		config.PDBName = "salespdb"
+		if got := ruleIDs(Identification(config), SeverityError); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Identification() errors = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
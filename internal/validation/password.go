@@ -0,0 +1,93 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/pwpolicy"
+)
+
+// PasswordPolicy returns the policy new account passwords are checked
+// against; an unknown name falls back to the default policy
+func PasswordPolicy(config *model.DBConfig) pwpolicy.Policy {
+	if p, ok := pwpolicy.Lookup(config.PasswordPolicy); ok {
+		return p
+	}
+	return pwpolicy.Policies[0]
+}
+
+// passwordAccount returns the account a new password is set for, with
+// the database names of the configured operation
+func passwordAccount(config *model.DBConfig, username string) pwpolicy.Account {
+	var names []string
+	switch config.Operation {
+	case model.OperationCreatePDB:
+		names = []string{config.SourceCDB, config.NewPDBName}
+	case model.OperationConfigure:
+		names = []string{config.ConfigureSID}
+	default:
+		dbName, _ := SplitGlobalName(config.GlobalDBName)
+		names = []string{config.SID, dbName}
+		if config.CreateAsContainerDB {
+			names = append(names, config.PDBName)
+		}
+	}
+	return pwpolicy.Account{Username: username, DBNames: names}
+}
+
+// checkPasswordPolicy reports a policy name no policy is registered under
+func checkPasswordPolicy(config *model.DBConfig, fs *findings) {
+	if _, ok := pwpolicy.Lookup(config.PasswordPolicy); !ok {
+		names := make([]string, len(pwpolicy.Policies))
+		for i, p := range pwpolicy.Policies {
+			names[i] = p.Name
+		}
+		fs.error("PWD-POLICY-UNKNOWN", "PasswordPolicy",
+			fmt.Sprintf("Unknown password policy %q (expected one of %s)", config.PasswordPolicy, strings.Join(names, ", ")))
+	}
+}
+
+// PasswordProblem returns the first policy rule a new password breaks for
+// any of the accounts it is set for, or an empty string
+func PasswordProblem(config *model.DBConfig, password string, usernames ...string) string {
+	policy := PasswordPolicy(config)
+	for _, user := range usernames {
+		if msg := policy.Check(password, passwordAccount(config, user)); msg != "" {
+			return msg
+		}
+	}
+	return ""
+}
+
+// GeneratePassword returns a random password complying with the policy
+// for the accounts it is set for
+func GeneratePassword(config *model.DBConfig, usernames ...string) (string, error) {
+	accounts := make([]pwpolicy.Account, len(usernames))
+	for i, user := range usernames {
+		accounts[i] = passwordAccount(config, user)
+	}
+	return PasswordPolicy(config).Generate(accounts...)
+}
+
+// checkNewPassword applies the password policy to the password of the
+// accounts the operation creates with it
+func checkNewPassword(config *model.DBConfig, fs *findings, ruleID, field, label, password string, usernames ...string) {
+	if password == "" {
+		return
+	}
+	if msg := PasswordProblem(config, password, usernames...); msg != "" {
+		fs.error(ruleID, field, label+strings.TrimPrefix(msg, "Password"))
+	}
+}
+
+// checkExistingPassword warns about a password of an existing account
+// that DBCA will not accept; no policy applies to it
+func checkExistingPassword(fs *findings, field, label, password string) {
+	if password == "" {
+		return
+	}
+	if msg := pwpolicy.CheckFormat(password); msg != "" {
+		fs.warning("PWD-FORMAT", field, label+strings.TrimPrefix(msg, "Password"))
+	}
+}
@@ -0,0 +1,208 @@
+package validation
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// maxAdminUserLength is the longest user name Oracle accepts from 12.2 on
+const maxAdminUserLength = 128
+
+// CreatePDB validates the create pluggable database options
+func CreatePDB(config *model.DBConfig) []Finding {
+	var fs findings
+
+	checkSourceCDB(config, &fs)
+
+	name := strings.TrimSpace(config.NewPDBName)
+	if name == "" {
+		fs.error("PDB-NAME-REQUIRED", "NewPDBName", "PDB name is required")
+	} else if msg := checkPDBName(name, "", 1); msg != "" {
+		fs.error("PDB-NAME-FORMAT", "NewPDBName", msg)
+	}
+
+	switch config.PDBSource {
+	case model.PDBSourceSeed:
+	case model.PDBSourceClone:
+		source := strings.TrimSpace(config.SourcePDB)
+		if source == "" {
+			fs.error("PDB-SOURCE-REQUIRED", "SourcePDB", "Source PDB to clone is required")
+		} else if strings.EqualFold(source, name) {
+			fs.error("PDB-SOURCE-SAME", "SourcePDB", "The new PDB name must differ from the source PDB")
+		}
+	case model.PDBSourceXML:
+		checkMetadataFile(config, &fs)
+	default:
+		fs.error("PDB-SOURCE-UNKNOWN", "PDBSource", fmt.Sprintf("Unknown PDB source %q", config.PDBSource))
+	}
+
+	// Datafile placement; plugging in may keep the locations from the XML file
+	if !config.PDBUseOMF {
+		convert := strings.TrimSpace(config.PDBFileNameConvert)
+		if convert == "" && config.PDBSource != model.PDBSourceXML {
+			fs.error("PDB-FNC-REQUIRED", "PDBFileNameConvert", "File name convert pairs are required when OMF is not used")
+		} else if convert != "" {
+			if msg := checkFileNameConvert(convert); msg != "" {
+				fs.error("PDB-FNC-FORMAT", "PDBFileNameConvert", msg)
+			}
+		}
+	}
+
+	// A PDB created from the seed needs a new local administrator
+	if config.PDBSource == model.PDBSourceSeed {
+		user := strings.TrimSpace(config.PDBAdminUser)
+		if user == "" {
+			fs.error("PDB-ADMIN-REQUIRED", "PDBAdminUser", "PDB administrator user name is required")
+		} else if msg := checkName("PDB administrator", user, maxAdminUserLength, "_$#"); msg != "" {
+			fs.error("PDB-ADMIN-FORMAT", "PDBAdminUser", msg)
+		} else if strings.EqualFold(user, "SYS") || strings.EqualFold(user, "SYSTEM") {
+			fs.error("PDB-ADMIN-FORMAT", "PDBAdminUser", "PDB administrator must not be SYS or SYSTEM")
+		}
+
+		checkPasswordPolicy(config, &fs)
+		if config.PDBAdminPassword == "" {
+			fs.error("PDB-ADMIN-PASSWORD-REQUIRED", "PDBAdminPassword", "PDB administrator password is required")
+		}
+		checkNewPassword(config, &fs, "PDB-ADMIN-PASSWORD-POLICY", "PDBAdminPassword",
+			"PDB administrator password", config.PDBAdminPassword, user)
+	}
+
+	return fs
+}
+
+// DeletePDB validates the delete pluggable database options
+func DeletePDB(config *model.DBConfig) []Finding {
+	var fs findings
+
+	checkSourceCDB(config, &fs)
+	checkSysDBA(config, &fs, "PDB-SYS-REQUIRED")
+	checkTargetPDB(config, &fs)
+
+	return fs
+}
+
+// UnplugPDB validates the unplug pluggable database options
+func UnplugPDB(config *model.DBConfig) []Finding {
+	var fs findings
+
+	checkSourceCDB(config, &fs)
+	checkSysDBA(config, &fs, "PDB-SYS-REQUIRED")
+	checkTargetPDB(config, &fs)
+	checkPDBArchive(config, &fs)
+
+	return fs
+}
+
+// PlugPDB validates the plug pluggable database options
+func PlugPDB(config *model.DBConfig) []Finding {
+	var fs findings
+
+	checkSourceCDB(config, &fs)
+	checkSysDBA(config, &fs, "PDB-SYS-REQUIRED")
+
+	name := strings.TrimSpace(config.NewPDBName)
+	if name == "" {
+		fs.error("PDB-NAME-REQUIRED", "NewPDBName", "PDB name is required")
+	} else if msg := checkPDBName(name, "", 1); msg != "" {
+		fs.error("PDB-NAME-FORMAT", "NewPDBName", msg)
+	}
+
+	checkPDBArchive(config, &fs)
+
+	if convert := strings.TrimSpace(config.PDBSourceFileNameConvert); convert != "" {
+		if msg := checkFileNameConvert(convert); msg != "" {
+			fs.error("PDB-SOURCE-FNC-FORMAT", "PDBSourceFileNameConvert", msg)
+		}
+	}
+	if convert := strings.TrimSpace(config.PDBFileNameConvert); convert != "" {
+		if msg := checkFileNameConvert(convert); msg != "" {
+			fs.error("PDB-FNC-FORMAT", "PDBFileNameConvert", msg)
+		}
+	} else if config.PDBArchiveType == model.PDBArchiveNone && config.PDBCopyFiles {
+		fs.warning("PDB-FNC-OMF", "PDBFileNameConvert", "Without file name convert pairs the copied datafiles are placed by db_create_file_dest")
+	}
+
+	return fs
+}
+
+// checkTargetPDB validates the name of the existing PDB an operation works on
+func checkTargetPDB(config *model.DBConfig, fs *findings) {
+	name := strings.TrimSpace(config.TargetPDB)
+	if name == "" {
+		fs.error("PDB-TARGET-REQUIRED", "TargetPDB", "PDB name is required")
+	} else if msg := checkPDBName(name, "", 1); msg != "" {
+		fs.error("PDB-TARGET-FORMAT", "TargetPDB", msg)
+	}
+}
+
+// checkPDBArchive validates the files an unplugged PDB is written to or read from
+func checkPDBArchive(config *model.DBConfig, fs *findings) {
+	switch config.PDBArchiveType {
+	case model.PDBArchiveTAR:
+		file := strings.TrimSpace(config.PDBArchiveFile)
+		if file == "" {
+			fs.error("PDB-ARCHIVE-REQUIRED", "PDBArchiveFile", "PDB archive file is required")
+			return
+		}
+		if !filepath.IsAbs(file) {
+			fs.error("PDB-ARCHIVE-PATH", "PDBArchiveFile", "PDB archive file must be an absolute path")
+		}
+		if !strings.HasSuffix(strings.ToLower(file), ".tar.gz") {
+			fs.warning("PDB-ARCHIVE-EXT", "PDBArchiveFile", "DBCA writes PDB archives as .tar.gz files")
+		}
+	case model.PDBArchiveRMAN:
+		file := strings.TrimSpace(config.PDBBackupFile)
+		if file == "" {
+			fs.error("PDB-BACKUP-REQUIRED", "PDBBackupFile", "RMAN backup file is required")
+		} else if !filepath.IsAbs(file) {
+			fs.error("PDB-BACKUP-PATH", "PDBBackupFile", "RMAN backup file must be an absolute path")
+		}
+		checkMetadataFile(config, fs)
+	case model.PDBArchiveNone:
+		checkMetadataFile(config, fs)
+	default:
+		fs.error("PDB-ARCHIVE-TYPE-UNKNOWN", "PDBArchiveType", fmt.Sprintf("Unknown PDB archive type %q", config.PDBArchiveType))
+	}
+}
+
+// checkMetadataFile validates the XML file describing an unplugged PDB
+func checkMetadataFile(config *model.DBConfig, fs *findings) {
+	file := strings.TrimSpace(config.PDBMetadataFile)
+	if file == "" {
+		fs.error("PDB-XML-REQUIRED", "PDBMetadataFile", "XML metadata file is required")
+		return
+	}
+	if !filepath.IsAbs(file) {
+		fs.error("PDB-XML-PATH", "PDBMetadataFile", "XML metadata file must be an absolute path")
+	}
+	if !strings.EqualFold(filepath.Ext(file), ".xml") {
+		fs.warning("PDB-XML-EXT", "PDBMetadataFile", "XML metadata file usually has an .xml extension")
+	}
+}
+
+// checkSourceCDB validates the SID of the container database a PDB operation runs against
+func checkSourceCDB(config *model.DBConfig, fs *findings) {
+	cdb := strings.TrimSpace(config.SourceCDB)
+	if cdb == "" {
+		fs.error("PDB-CDB-REQUIRED", "SourceCDB", "Container database SID is required")
+	} else if msg := checkSID(cdb); msg != "" {
+		fs.error("PDB-CDB-FORMAT", "SourceCDB", msg)
+	}
+}
+
+// checkFileNameConvert validates a FILE_NAME_CONVERT list of source/target pairs
+func checkFileNameConvert(value string) string {
+	parts := model.SplitFileNameConvert(value)
+	if len(parts)%2 != 0 {
+		return "File name convert must list source and target patterns in pairs, e.g. '/pdbseed/','/newpdb/'"
+	}
+	for _, p := range parts {
+		if p == "" {
+			return "File name convert patterns must not be empty"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,168 @@
+package validation
+
+import (
+	"slices"
+	"testing"
+
+	"dbca_tui/internal/model"
+)
+
+// pdbConfig returns a valid config for the given pluggable database operation
+func pdbConfig(operation model.Operation) *model.DBConfig {
+	c := model.NewDBConfig()
+	c.Operation = operation
+	c.SourceCDB = "cdb1"
+	c.SysPassword = "Kx7_pq2m"
+	c.PDBAdminPassword = "Kx7_pq2m"
+	c.NewPDBName = "salespdb"
+	c.TargetPDB = "hrpdb"
+	c.PDBArchiveFile = "/backup/hrpdb.tar.gz"
+	c.PDBBackupFile = "/backup/hrpdb.bkp"
+	c.PDBMetadataFile = "/backup/hrpdb.xml"
+	return c
+}
+
+func TestCreatePDB(t *testing.T) {
+	tests := []struct {
+		name     string
+		edit     func(c *model.DBConfig)
+		want     []string
+		warnings []string
+	}{
+		{"valid", func(c *model.DBConfig) {}, nil, nil},
+		{"missing CDB", func(c *model.DBConfig) { c.SourceCDB = " " }, []string{"PDB-CDB-REQUIRED"}, nil},
+		{"CDB SID format", func(c *model.DBConfig) { c.SourceCDB = "cdb-1" }, []string{"PDB-CDB-FORMAT"}, nil},
+		{"missing name", func(c *model.DBConfig) { c.NewPDBName = "" }, []string{"PDB-NAME-REQUIRED"}, nil},
+		{"reserved name", func(c *model.DBConfig) { c.NewPDBName = "PDB$SEED" }, []string{"PDB-NAME-FORMAT"}, nil},
+		{"clone without source", func(c *model.DBConfig) { c.PDBSource = model.PDBSourceClone }, []string{"PDB-SOURCE-REQUIRED"}, nil},
+		{"clone of itself", func(c *model.DBConfig) {
+			c.PDBSource = model.PDBSourceClone
+			c.SourcePDB = "SALESPDB"
+		}, []string{"PDB-SOURCE-SAME"}, nil},
+		{"XML without file", func(c *model.DBConfig) {
+			c.PDBSource = model.PDBSourceXML
+			c.PDBMetadataFile = ""
+		}, []string{"PDB-XML-REQUIRED"}, nil},
+		{"XML file path", func(c *model.DBConfig) {
+			c.PDBSource = model.PDBSourceXML
+			c.PDBMetadataFile = "hrpdb.txt"
+		}, []string{"PDB-XML-PATH"}, []string{"PDB-XML-EXT"}},
+		{"unknown source", func(c *model.DBConfig) { c.PDBSource = "SNAPSHOT" }, []string{"PDB-SOURCE-UNKNOWN"}, nil},
+		{"no OMF without convert", func(c *model.DBConfig) { c.PDBUseOMF = false }, []string{"PDB-FNC-REQUIRED"}, nil},
+		{"odd convert list", func(c *model.DBConfig) {
+			c.PDBUseOMF = false
+			c.PDBFileNameConvert = "'/pdbseed/'"
+		}, []string{"PDB-FNC-FORMAT"}, nil},
+		{"XML keeps its locations", func(c *model.DBConfig) {
+			c.PDBSource = model.PDBSourceXML
+			c.PDBUseOMF = false
+		}, nil, nil},
+		{"missing admin", func(c *model.DBConfig) { c.PDBAdminUser = "" }, []string{"PDB-ADMIN-REQUIRED"}, nil},
+		{"admin is SYSTEM", func(c *model.DBConfig) { c.PDBAdminUser = "system" }, []string{"PDB-ADMIN-FORMAT"}, nil},
+		{"admin format", func(c *model.DBConfig) { c.PDBAdminUser = "pdb-admin" }, []string{"PDB-ADMIN-FORMAT"}, nil},
+		{"missing admin password", func(c *model.DBConfig) { c.PDBAdminPassword = "" }, []string{"PDB-ADMIN-PASSWORD-REQUIRED"}, nil},
+		{"weak admin password", func(c *model.DBConfig) { c.PDBAdminPassword = "short1" }, []string{"PDB-ADMIN-PASSWORD-POLICY"}, nil},
+		{"clone needs no admin", func(c *model.DBConfig) {
+			c.PDBSource = model.PDBSourceClone
+			c.SourcePDB = "hrpdb"
+			c.PDBAdminUser = ""
+			c.PDBAdminPassword = ""
+		}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		config := pdbConfig(model.OperationCreatePDB)
+		tt.edit(config)
+		findings := CreatePDB(config)
+		if got := ruleIDs(findings, SeverityError); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: CreatePDB() errors = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := ruleIDs(findings, SeverityWarning); !slices.Equal(got, tt.warnings) {
+			t.Errorf("%s: CreatePDB() warnings = %v, want %v", tt.name, got, tt.warnings)
+		}
+	}
+}
+
+func TestExistingPDBOperations(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation model.Operation
+		edit      func(c *model.DBConfig)
+		want      []string
+		warnings  []string
+	}{
+		{"delete", model.OperationDeletePDB, func(c *model.DBConfig) {}, nil, nil},
+		{"delete without SYS password", model.OperationDeletePDB, func(c *model.DBConfig) { c.SysPassword = "" }, []string{"PDB-SYS-REQUIRED"}, nil},
+		{"delete with operating system authentication", model.OperationDeletePDB, func(c *model.DBConfig) {
+			c.SysPassword = ""
+			c.UseOSAuthentication = true
+		}, nil, nil},
+		{"PDB operations take no wallet", model.OperationDeletePDB, func(c *model.DBConfig) {
+			c.SysPassword = ""
+			c.UseWallet = true
+		}, []string{"PDB-SYS-REQUIRED"}, nil},
+		{"SYS password DBCA rejects", model.OperationDeletePDB, func(c *model.DBConfig) { c.SysPassword = "Kx7@pq2m" }, nil, []string{"PWD-FORMAT"}},
+		{"delete without target", model.OperationDeletePDB, func(c *model.DBConfig) { c.TargetPDB = "" }, []string{"PDB-TARGET-REQUIRED"}, nil},
+		{"delete target format", model.OperationDeletePDB, func(c *model.DBConfig) { c.TargetPDB = "1pdb" }, []string{"PDB-TARGET-FORMAT"}, nil},
+		{"unplug", model.OperationUnplugPDB, func(c *model.DBConfig) {}, nil, nil},
+		{"unplug without archive", model.OperationUnplugPDB, func(c *model.DBConfig) { c.PDBArchiveFile = "" }, []string{"PDB-ARCHIVE-REQUIRED"}, nil},
+		{"unplug archive path", model.OperationUnplugPDB, func(c *model.DBConfig) { c.PDBArchiveFile = "hrpdb.zip" }, []string{"PDB-ARCHIVE-PATH"}, []string{"PDB-ARCHIVE-EXT"}},
+		{"unplug RMAN without backup", model.OperationUnplugPDB, func(c *model.DBConfig) {
+			c.PDBArchiveType = model.PDBArchiveRMAN
+			c.PDBBackupFile = ""
+			c.PDBMetadataFile = ""
+		}, []string{"PDB-BACKUP-REQUIRED", "PDB-XML-REQUIRED"}, nil},
+		{"unplug RMAN backup path", model.OperationUnplugPDB, func(c *model.DBConfig) {
+			c.PDBArchiveType = model.PDBArchiveRMAN
+			c.PDBBackupFile = "hrpdb.bkp"
+		}, []string{"PDB-BACKUP-PATH"}, nil},
+		{"unplug unknown archive type", model.OperationUnplugPDB, func(c *model.DBConfig) { c.PDBArchiveType = "ZIP" }, []string{"PDB-ARCHIVE-TYPE-UNKNOWN"}, nil},
+		{"plug", model.OperationPlugPDB, func(c *model.DBConfig) {}, nil, nil},
+		{"plug without name", model.OperationPlugPDB, func(c *model.DBConfig) { c.NewPDBName = "" }, []string{"PDB-NAME-REQUIRED"}, nil},
+		{"plug name format", model.OperationPlugPDB, func(c *model.DBConfig) { c.NewPDBName = "cdb$root" }, []string{"PDB-NAME-FORMAT"}, nil},
+		{"plug convert lists", model.OperationPlugPDB, func(c *model.DBConfig) {
+			c.PDBSourceFileNameConvert = "'/a/',''"
+			c.PDBFileNameConvert = "'/a/','/b/','/c/'"
+		}, []string{"PDB-SOURCE-FNC-FORMAT", "PDB-FNC-FORMAT"}, nil},
+		{"plug copy without convert", model.OperationPlugPDB, func(c *model.DBConfig) {
+			c.PDBArchiveType = model.PDBArchiveNone
+			c.PDBCopyFiles = true
+		}, nil, []string{"PDB-FNC-OMF"}},
+	}
+
+	validate := map[model.Operation]func(*model.DBConfig) []Finding{
+		model.OperationDeletePDB: DeletePDB,
+		model.OperationUnplugPDB: UnplugPDB,
+		model.OperationPlugPDB:   PlugPDB,
+	}
+	for _, tt := range tests {
+		config := pdbConfig(tt.operation)
+		tt.edit(config)
+		findings := validate[tt.operation](config)
+		if got := ruleIDs(findings, SeverityError); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: errors = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := ruleIDs(findings, SeverityWarning); !slices.Equal(got, tt.warnings) {
+			t.Errorf("%s: warnings = %v, want %v", tt.name, got, tt.warnings)
+		}
+	}
+}
+
+func TestCheckFileNameConvert(t *testing.T) {
+	tests := []struct {
+		value string
+		valid bool
+	}{
+		{"'/pdbseed/','/salespdb/'", true},
+		{"'/a/','/b/','/c/','/d/'", true},
+		{"/a/,/b/", true},
+		{"'/pdbseed/'", false},
+		{"'/pdbseed/',''", false},
+	}
+
+	for _, tt := range tests {
+		if got := checkFileNameConvert(tt.value); (got == "") != tt.valid {
+			t.Errorf("checkFileNameConvert(%q) = %q, want valid %t", tt.value, got, tt.valid)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// RelocatePDB validates the relocate pluggable database options
+func RelocatePDB(config *model.DBConfig) []Finding {
+	var fs findings
+
+	checkSourceCDB(config, &fs)
+	checkSysDBA(config, &fs, "PDB-SYS-REQUIRED")
+	checkTargetPDB(config, &fs)
+
+	if name := strings.TrimSpace(config.NewPDBName); name != "" {
+		if msg := checkPDBName(name, "", 1); msg != "" {
+			fs.error("PDB-NAME-FORMAT", "NewPDBName", msg)
+		}
+	}
+
+	remote := strings.TrimSpace(config.RemoteCDBConnectString)
+	if remote == "" {
+		fs.error("REL-REMOTE-REQUIRED", "RemoteCDBConnectString", "Connect string of the remote CDB is required")
+	} else if !isEZConnect(remote) {
+		fs.error("REL-REMOTE-FORMAT", "RemoteCDBConnectString", "Remote CDB must be an EZCONNECT string such as host:1521/service")
+	} else if msg := checkEZConnectPort(remote); msg != "" {
+		fs.error("REL-REMOTE-FORMAT", "RemoteCDBConnectString", msg)
+	}
+
+	if strings.TrimSpace(config.RemoteSysDBAUser) == "" {
+		fs.error("REL-REMOTE-USER-REQUIRED", "RemoteSysDBAUser", "SYSDBA user of the remote CDB is required")
+	}
+	if config.RemoteSysDBAPassword == "" {
+		fs.error("REL-REMOTE-PASSWORD-REQUIRED", "RemoteSysDBAPassword", "SYSDBA password of the remote CDB is required")
+	}
+	checkExistingPassword(&fs, "RemoteSysDBAPassword", "SYSDBA password of the remote CDB", config.RemoteSysDBAPassword)
+
+	// The database link connects to the root of the remote CDB
+	user := strings.TrimSpace(config.DBLinkUser)
+	if user == "" {
+		fs.error("REL-DBLINK-USER-REQUIRED", "DBLinkUser", "Database link user is required")
+	} else if !strings.HasPrefix(strings.ToUpper(user), "C##") {
+		fs.warning("REL-DBLINK-COMMON", "DBLinkUser", "The database link user should be a common user (C##) with CREATE PLUGGABLE DATABASE and SYSOPER")
+	}
+	if config.DBLinkPassword == "" {
+		fs.error("REL-DBLINK-PASSWORD-REQUIRED", "DBLinkPassword", "Database link user password is required")
+	}
+	checkExistingPassword(&fs, "DBLinkPassword", "Database link user password", config.DBLinkPassword)
+
+	return fs
+}
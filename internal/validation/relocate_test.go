@@ -0,0 +1,50 @@
+package validation
+
+import (
+	"slices"
+	"testing"
+
+	"dbca_tui/internal/model"
+)
+
+func TestRelocatePDB(t *testing.T) {
+	tests := []struct {
+		name     string
+		edit     func(c *model.DBConfig)
+		want     []string
+		warnings []string
+	}{
+		{"valid", func(c *model.DBConfig) {}, nil, nil},
+		{"keeps the remote name", func(c *model.DBConfig) { c.NewPDBName = "" }, nil, nil},
+		{"new name format", func(c *model.DBConfig) { c.NewPDBName = "pdb$seed" }, []string{"PDB-NAME-FORMAT"}, nil},
+		{"missing SYS password", func(c *model.DBConfig) { c.SysPassword = "" }, []string{"PDB-SYS-REQUIRED"}, nil},
+		{"missing remote PDB", func(c *model.DBConfig) { c.TargetPDB = "" }, []string{"PDB-TARGET-REQUIRED"}, nil},
+		{"missing remote CDB", func(c *model.DBConfig) { c.RemoteCDBConnectString = "" }, []string{"REL-REMOTE-REQUIRED"}, nil},
+		{"remote CDB without service", func(c *model.DBConfig) { c.RemoteCDBConnectString = "host1:1521" }, []string{"REL-REMOTE-FORMAT"}, nil},
+		{"remote CDB port", func(c *model.DBConfig) { c.RemoteCDBConnectString = "host1:70000/cdb1" }, []string{"REL-REMOTE-FORMAT"}, nil},
+		{"missing remote user", func(c *model.DBConfig) { c.RemoteSysDBAUser = " " }, []string{"REL-REMOTE-USER-REQUIRED"}, nil},
+		{"missing remote password", func(c *model.DBConfig) { c.RemoteSysDBAPassword = "" }, []string{"REL-REMOTE-PASSWORD-REQUIRED"}, nil},
+		{"remote password DBCA rejects", func(c *model.DBConfig) { c.RemoteSysDBAPassword = `Kx7"pq2m` }, nil, []string{"PWD-FORMAT"}},
+		{"missing link user", func(c *model.DBConfig) { c.DBLinkUser = "" }, []string{"REL-DBLINK-USER-REQUIRED"}, nil},
+		{"local link user", func(c *model.DBConfig) { c.DBLinkUser = "linkuser" }, nil, []string{"REL-DBLINK-COMMON"}},
+		{"missing link password", func(c *model.DBConfig) { c.DBLinkPassword = "" }, []string{"REL-DBLINK-PASSWORD-REQUIRED"}, nil},
+	}
+
+	for _, tt := range tests {
+		config := pdbConfig(model.OperationRelocatePDB)
+		config.NewPDBName = "hrpdb2"
+		config.RemoteCDBConnectString = "host1:1521/cdb2"
+		config.RemoteSysDBAPassword = "Remote_Pwd1"
+		config.DBLinkUser = "c##link"
+		config.DBLinkPassword = "Link_Pwd1"
+		tt.edit(config)
+
+		findings := RelocatePDB(config)
+		if got := ruleIDs(findings, SeverityError); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: RelocatePDB() errors = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := ruleIDs(findings, SeverityWarning); !slices.Equal(got, tt.warnings) {
+			t.Errorf("%s: RelocatePDB() warnings = %v, want %v", tt.name, got, tt.warnings)
+		}
+	}
+}
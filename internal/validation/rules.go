@@ -0,0 +1,361 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// Release validates the target Oracle release
+func Release(config *model.DBConfig) []Finding {
+	var fs findings
+
+	if !config.TargetVersion.IsSupported() {
+		fs.error("VER-UNSUPPORTED", "TargetVersion",
+			fmt.Sprintf("Unsupported target release %q (expected one of %s)", config.TargetVersion, supportedVersionList()))
+	}
+
+	return fs
+}
+
+// OracleHome validates the home the dbca executable is run from
+func OracleHome(config *model.DBConfig) []Finding {
+	var fs findings
+
+	home := config.OracleHome
+	if home == "" {
+		return fs
+	}
+	if !strings.HasPrefix(home, "/") && !isWindowsPath(home) {
+		fs.error("HOME-PATH", "OracleHome", "ORACLE_HOME must be an absolute path")
+	}
+	if strings.ContainsAny(home, "\n\r") {
+		fs.error("HOME-FORMAT", "OracleHome", "ORACLE_HOME must not contain line breaks")
+	}
+
+	return fs
+}
+
+// isWindowsPath returns true for a path starting with a drive letter, such as C:\app\oracle
+func isWindowsPath(value string) bool {
+	return len(value) >= 3 && value[1] == ':' && (value[2] == '\\' || value[2] == '/') &&
+		(value[0] >= 'a' && value[0] <= 'z' || value[0] >= 'A' && value[0] <= 'Z')
+}
+
+// Identification validates the database name, SID and PDB settings
+func Identification(config *model.DBConfig) []Finding {
+	var fs findings
+
+	checkGlobalNameAndSID(config, &fs)
+	dbName, _ := SplitGlobalName(config.GlobalDBName)
+
+	if !config.CreateAsContainerDB && !config.TargetVersion.SupportsNonCDB() {
+		fs.error("VER-NONCDB-UNSUPPORTED", "CreateAsContainerDB",
+			fmt.Sprintf("Oracle %s only supports container databases; enable Create as Container Database", config.TargetVersion))
+	}
+
+	if config.CreateAsContainerDB {
+		if config.NumberOfPDBs < 0 || config.NumberOfPDBs > 252 {
+			fs.error("ID-PDB-COUNT", "NumberOfPDBs", "Number of PDBs must be between 0 and 252")
+		}
+		pdbName := strings.TrimSpace(config.PDBName)
+		if config.NumberOfPDBs > 0 && pdbName == "" {
+			fs.error("ID-PDB-NAME-REQUIRED", "PDBName", "PDB Name/Prefix is required when creating PDBs")
+		} else if config.NumberOfPDBs > 0 {
+			if msg := checkPDBName(pdbName, dbName, config.NumberOfPDBs); msg != "" {
+				fs.error("ID-PDB-NAME-FORMAT", "PDBName", msg)
+			}
+		}
+	}
+
+	return fs
+}
+
+// checkGlobalNameAndSID validates the global database name and SID of a new database
+func checkGlobalNameAndSID(config *model.DBConfig, fs *findings) {
+	dbName, dbDomain := SplitGlobalName(config.GlobalDBName)
+	if strings.TrimSpace(config.GlobalDBName) == "" {
+		fs.error("ID-GDBNAME-REQUIRED", "GlobalDBName", "Global Database Name is required")
+	} else {
+		if msg := checkDBName(dbName); msg != "" {
+			fs.error("ID-DBNAME-FORMAT", "GlobalDBName", msg)
+		}
+		if msg := checkDBDomain(dbDomain); msg != "" {
+			fs.error("ID-DBDOMAIN-FORMAT", "GlobalDBName", msg)
+		}
+	}
+
+	sid := strings.TrimSpace(config.SID)
+	if sid == "" {
+		fs.error("ID-SID-REQUIRED", "SID", "SID is required")
+	} else if msg := checkSID(sid); msg != "" {
+		fs.error("ID-SID-FORMAT", "SID", msg)
+	}
+}
+
+// Storage validates the storage destinations
+func Storage(config *model.DBConfig) []Finding {
+	var fs findings
+
+	if config.StorageType == model.StorageTypeASM {
+		if strings.TrimSpace(config.ASMDiskGroup) == "" {
+			fs.error("STG-ASM-DISKGROUP-REQUIRED", "ASMDiskGroup", "ASM Disk Group is required")
+		}
+	} else if strings.TrimSpace(config.DatafileDestination) == "" {
+		fs.error("STG-DATAFILE-DEST-REQUIRED", "DatafileDestination", "Datafile destination is required")
+	}
+
+	return fs
+}
+
+// Recovery validates the Fast Recovery Area and archive log settings
+func Recovery(config *model.DBConfig) []Finding {
+	var fs findings
+
+	if config.EnableFRA {
+		if strings.TrimSpace(config.FRADestination) == "" {
+			fs.error("REC-FRA-DEST-REQUIRED", "FRADestination", "Fast Recovery Area location is required")
+		}
+		if config.FRASize < 1024 {
+			fs.error("REC-FRA-SIZE", "FRASize", "FRA size must be at least 1024 MB")
+		}
+	}
+
+	if !config.EnableArchiveLog {
+		fs.info("REC-NOARCHIVELOG", "EnableArchiveLog",
+			"Database runs in NOARCHIVELOG mode; online backups and point-in-time recovery are not possible")
+	} else if !config.EnableFRA {
+		fs.warning("REC-ARCHIVE-NO-FRA", "EnableFRA",
+			"Archive log mode without a Fast Recovery Area writes archived logs to the default destination")
+	}
+
+	return fs
+}
+
+// Network validates the listener settings
+func Network(config *model.DBConfig) []Finding {
+	var fs findings
+
+	if strings.TrimSpace(config.ListenerName) == "" {
+		fs.error("NET-LISTENER-REQUIRED", "ListenerName", "Listener name is required")
+	}
+	if config.ListenerPort < 1 || config.ListenerPort > 65535 {
+		fs.error("NET-PORT-RANGE", "ListenerPort", "Port must be between 1 and 65535")
+	}
+
+	return fs
+}
+
+// DataVault validates the Oracle Data Vault settings
+func DataVault(config *model.DBConfig) []Finding {
+	var fs findings
+
+	if config.EnableDataVault {
+		if strings.TrimSpace(config.DataVaultOwner) == "" {
+			fs.error("DV-OWNER-REQUIRED", "DataVaultOwner", "Data Vault Owner is required")
+		}
+		if strings.TrimSpace(config.DataVaultAccountManager) == "" {
+			fs.error("DV-ACCTMGR-REQUIRED", "DataVaultAccountManager", "Data Vault Account Manager is required")
+		}
+	}
+
+	return fs
+}
+
+// Configuration validates memory and other configuration options
+func Configuration(config *model.DBConfig) []Finding {
+	var fs findings
+
+	if config.TotalMemory < 256 {
+		fs.error("MEM-TOTAL-MIN", "TotalMemory", "Memory size must be at least 256 MB")
+	} else if config.TotalMemory < 2048 {
+		fs.warning("MEM-TOTAL-LOW", "TotalMemory", "At least 2048 MB of memory is recommended")
+	}
+
+	if config.MemoryManagement == "AUTO" && config.TotalMemory > 4096 {
+		fs.warning("MEM-AMM-LARGE", "MemoryManagement",
+			"Automatic Memory Management is not recommended above 4 GB; consider Automatic Shared Memory Management")
+	}
+
+	if config.MemoryManagement == "AUTO_SGA" || config.MemoryManagement == "MANUAL" {
+		memorySizing(config, &fs)
+	}
+
+	return fs
+}
+
+// memorySizing validates the SGA/PGA sizes of AUTO_SGA and MANUAL memory management
+func memorySizing(config *model.DBConfig, fs *findings) {
+	sgaLabel := "SGA target"
+	if config.MemoryManagement == "MANUAL" {
+		sgaLabel = "SGA maximum size"
+	}
+
+	if config.SGASize <= 0 {
+		fs.error("MEM-SGA-REQUIRED", "SGASize", sgaLabel+" must be greater than 0 MB")
+	}
+	if config.PGASize <= 0 {
+		fs.error("MEM-PGA-REQUIRED", "PGASize", "PGA aggregate target must be greater than 0 MB")
+	}
+	if config.SGASize > 0 && config.PGASize > 0 && config.SGASize+config.PGASize > config.TotalMemory {
+		fs.error("MEM-SIZES-EXCEED-TOTAL", "SGASize",
+			fmt.Sprintf("%s (%d MB) plus PGA aggregate target (%d MB) exceeds total memory (%d MB)",
+				sgaLabel, config.SGASize, config.PGASize, config.TotalMemory))
+	}
+
+	if config.MemoryManagement == "MANUAL" {
+		if config.SGATarget < 0 {
+			fs.error("MEM-SGA-TARGET-RANGE", "SGATarget", "SGA target must not be negative")
+		} else if config.SGATarget > config.SGASize {
+			fs.error("MEM-SGA-TARGET-RANGE", "SGATarget",
+				fmt.Sprintf("SGA target (%d MB) must not exceed the SGA maximum size (%d MB)", config.SGATarget, config.SGASize))
+		}
+	}
+
+	if config.PGAAggregateLimit < 0 {
+		fs.error("MEM-PGA-LIMIT-RANGE", "PGAAggregateLimit", "PGA aggregate limit must not be negative")
+	} else if config.PGAAggregateLimit > 0 {
+		if config.PGAAggregateLimit < config.PGASize {
+			fs.error("MEM-PGA-LIMIT-RANGE", "PGAAggregateLimit",
+				fmt.Sprintf("PGA aggregate limit (%d MB) must not be below the PGA aggregate target (%d MB)", config.PGAAggregateLimit, config.PGASize))
+		} else if config.PGAAggregateLimit < 2*config.PGASize {
+			fs.warning("MEM-PGA-LIMIT-LOW", "PGAAggregateLimit",
+				fmt.Sprintf("Oracle recommends a PGA aggregate limit of at least twice the PGA aggregate target (%d MB)", 2*config.PGASize))
+		}
+	}
+}
+
+// Management validates the Enterprise Manager settings
+func Management(config *model.DBConfig) []Finding {
+	var fs findings
+
+	if config.EMConfiguration == model.EMConfigDBExpress && !config.TargetVersion.SupportsEMExpress() {
+		fs.error("VER-EMEXPRESS-UNSUPPORTED", "EMConfiguration",
+			fmt.Sprintf("EM Express is not available in Oracle %s; choose Cloud Control or none", config.TargetVersion))
+	}
+
+	if config.EMConfiguration == model.EMConfigDBExpress || config.EMConfiguration == model.EMConfigCentral {
+		if config.EMPort < 1 || config.EMPort > 65535 {
+			fs.error("EM-PORT-RANGE", "EMPort", "Port must be between 1 and 65535")
+		}
+	}
+
+	if config.EMConfiguration == model.EMConfigCentral && strings.TrimSpace(config.CloudControlAgent) == "" {
+		fs.error("EM-AGENT-REQUIRED", "CloudControlAgent", "Cloud Control agent URL is required")
+	}
+
+	return fs
+}
+
+// Credentials validates the administrative passwords
+func Credentials(config *model.DBConfig) []Finding {
+	var fs findings
+
+	checkPasswordPolicy(config, &fs)
+
+	if config.UsesWallet() {
+		fs = append(fs, Wallet(config)...)
+		if config.CreateAsContainerDB && config.PDBAdminPassword == "" {
+			fs.error("CRED-PDBADMIN-REQUIRED", "PDBAdminPassword", "PDB Admin password is required")
+		}
+		checkNewPassword(config, &fs, "CRED-PDBADMIN-POLICY", "PDBAdminPassword", "PDB Admin password", config.PDBAdminPassword, "PDBADMIN")
+		return fs
+	}
+
+	if config.UseCommonPassword {
+		pwd := config.CommonPassword
+		if pwd == "" {
+			fs.error("CRED-COMMON-REQUIRED", "CommonPassword", "Password is required")
+		}
+		// The common password is set for every account, so none of their names may appear in it
+		checkNewPassword(config, &fs, "CRED-COMMON-POLICY", "CommonPassword", "Password", pwd, "SYS", "SYSTEM", "PDBADMIN")
+		return fs
+	}
+
+	if config.SysPassword == "" {
+		fs.error("CRED-SYS-REQUIRED", "SysPassword", "SYS password is required")
+	}
+	if config.SystemPassword == "" {
+		fs.error("CRED-SYSTEM-REQUIRED", "SystemPassword", "SYSTEM password is required")
+	}
+	if config.CreateAsContainerDB && config.PDBAdminPassword == "" {
+		fs.error("CRED-PDBADMIN-REQUIRED", "PDBAdminPassword", "PDB Admin password is required")
+	}
+	checkNewPassword(config, &fs, "CRED-SYS-POLICY", "SysPassword", "SYS password", config.SysPassword, "SYS")
+	checkNewPassword(config, &fs, "CRED-SYSTEM-POLICY", "SystemPassword", "SYSTEM password", config.SystemPassword, "SYSTEM")
+	if config.CreateAsContainerDB {
+		checkNewPassword(config, &fs, "CRED-PDBADMIN-POLICY", "PDBAdminPassword", "PDB Admin password", config.PDBAdminPassword, "PDBADMIN")
+	}
+
+	return fs
+}
+
+// Delete validates the delete database options
+func Delete(config *model.DBConfig) []Finding {
+	var fs findings
+
+	sid := strings.TrimSpace(config.DeleteSID)
+	if sid == "" {
+		fs.error("DEL-SID-REQUIRED", "DeleteSID", "Database SID is required")
+	} else if msg := checkSID(sid); msg != "" {
+		fs.error("DEL-SID-FORMAT", "DeleteSID", msg)
+	}
+
+	if config.UsesWallet() {
+		fs = append(fs, Wallet(config)...)
+	} else if config.SysPassword == "" {
+		fs.error("DEL-SYS-REQUIRED", "SysPassword", "SYS password is required for deletion")
+	} else {
+		checkExistingPassword(&fs, "SysPassword", "SYS password", config.SysPassword)
+	}
+
+	return fs
+}
+
+// Wallet validates the Oracle wallet the SYS credentials are read from
+func Wallet(config *model.DBConfig) []Finding {
+	var fs findings
+
+	location := strings.TrimSpace(config.WalletLocation)
+	switch {
+	case location == "":
+		fs.error("WALLET-LOCATION-REQUIRED", "WalletLocation", "Wallet location is required")
+	case !strings.HasPrefix(location, "/") && !isWindowsPath(location):
+		fs.error("WALLET-LOCATION-PATH", "WalletLocation", "Wallet location must be an absolute path")
+	}
+
+	if strings.ContainsAny(config.WalletAlias, " \t") {
+		fs.error("WALLET-ALIAS-FORMAT", "WalletAlias", "Wallet alias must not contain spaces")
+	}
+	if config.SysPassword != "" {
+		fs.info("WALLET-PASSWORD-IGNORED", "SysPassword", "The SYS password is not written; DBCA reads the credentials from the wallet")
+	}
+
+	return fs
+}
+
+// checkSysDBA validates how an operation on an existing database connects
+// as SYSDBA: with the wallet, with operating system authentication where
+// it was chosen, or with the SYS password
+func checkSysDBA(config *model.DBConfig, fs *findings, ruleID string) {
+	switch {
+	case config.UsesWallet():
+		*fs = append(*fs, Wallet(config)...)
+	case config.UsesOSAuthentication():
+		fs.info("SYSDBA-OS-AUTH", "SysPassword", "DBCA connects with operating system authentication")
+	case config.SysPassword == "":
+		fs.error(ruleID, "SysPassword", "SYS password is required")
+	default:
+		checkExistingPassword(fs, "SysPassword", "SYS password", config.SysPassword)
+	}
+}
+
+// supportedVersionList renders the supported releases for messages
+func supportedVersionList() string {
+	names := make([]string, len(model.SupportedVersions))
+	for i, v := range model.SupportedVersions {
+		names[i] = string(v)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,119 @@
+package validation
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"dbca_tui/internal/model"
+)
+
+// CreateTemplate validates the create template from database operation
+func CreateTemplate(config *model.DBConfig) []Finding {
+	var fs findings
+
+	source := strings.TrimSpace(config.TemplateSourceDB)
+	switch {
+	case source == "":
+		fs.error("TPL-SOURCE-REQUIRED", "TemplateSourceDB", "Source database is required")
+	case config.TemplateIncludeDatafiles:
+		// A clone template is taken with RMAN from a database on this host
+		if msg := checkSID(source); msg != "" {
+			fs.error("TPL-SOURCE-SID", "TemplateSourceDB", "A clone template is created from a local database; "+msg)
+		}
+	default:
+		if msg := checkConnectString(source); msg != "" {
+			fs.error("TPL-SOURCE-FORMAT", "TemplateSourceDB", msg)
+		}
+	}
+
+	checkSysDBA(config, &fs, "TPL-SYS-PASSWORD-REQUIRED")
+
+	name := strings.TrimSpace(config.NewTemplateName)
+	if name == "" {
+		fs.error("TPL-NAME-REQUIRED", "NewTemplateName", "Template name is required")
+	} else {
+		if strings.ContainsAny(name, `/\ `) {
+			fs.error("TPL-NAME-FORMAT", "NewTemplateName", "Template name must not contain spaces or path separators")
+		}
+		if ext := strings.ToLower(filepath.Ext(name)); ext == ".dbt" || ext == ".dbc" {
+			fs.warning("TPL-NAME-EXT", "NewTemplateName", "DBCA appends the template extension; the name should not include "+ext)
+		}
+	}
+
+	return fs
+}
+
+// Template validates the template a database is created from
+func Template(config *model.DBConfig) []Finding {
+	var fs findings
+
+	if !config.TemplateName.IsFile() {
+		return fs
+	}
+
+	file := strings.TrimSpace(string(config.TemplateName))
+	if file == "" {
+		fs.error("TPL-FILE-REQUIRED", "TemplateName", "Template file is required")
+		return fs
+	}
+	if ext := strings.ToLower(filepath.Ext(file)); ext != ".dbt" && ext != ".dbc" {
+		fs.error("TPL-FILE-EXT", "TemplateName", "Template file must be a .dbt or .dbc file")
+	}
+	if strings.Contains(file, "/") && !filepath.IsAbs(file) {
+		fs.error("TPL-FILE-PATH", "TemplateName", "Template file must be an absolute path or a name in the DBCA templates directory")
+	}
+
+	return fs
+}
+
+// checkConnectString validates a host:port:SID or EZCONNECT connect string
+func checkConnectString(value string) string {
+	const expected = "Source database must be host:port:SID or an EZCONNECT string such as host:1521/service"
+
+	if strings.ContainsAny(value, " \t") {
+		return expected
+	}
+
+	if strings.Contains(value, "/") {
+		if !isEZConnect(value) {
+			return expected
+		}
+		return checkEZConnectPort(value)
+	}
+
+	// host:port:SID
+	parts := strings.Split(value, ":")
+	if len(parts) != 3 || parts[0] == "" {
+		return expected
+	}
+	if msg := checkPort(parts[1]); msg != "" {
+		return msg
+	}
+	return checkSID(parts[2])
+}
+
+// isEZConnect returns true for a host[:port]/service connect string
+func isEZConnect(value string) bool {
+	host, service, found := strings.Cut(value, "/")
+	host, _, _ = strings.Cut(host, ":")
+	return found && host != "" && service != "" && !strings.ContainsAny(value, " \t")
+}
+
+// checkEZConnectPort validates the optional port of an EZCONNECT string
+func checkEZConnectPort(value string) string {
+	host, _, _ := strings.Cut(value, "/")
+	if _, port, hasPort := strings.Cut(host, ":"); hasPort {
+		return checkPort(port)
+	}
+	return ""
+}
+
+// checkPort validates a listener port in a connect string
+func checkPort(value string) string {
+	port, err := strconv.Atoi(value)
+	if err != nil || port < 1 || port > 65535 {
+		return "Port in the connect string must be between 1 and 65535"
+	}
+	return ""
+}
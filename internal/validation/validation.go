@@ -0,0 +1,173 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+
+	"dbca_tui/internal/hostprobe"
+	"dbca_tui/internal/model"
+)
+
+// Severity represents how serious a finding is
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Finding is a single validation result
+type Finding struct {
+	Field    string   // DBConfig field the finding refers to
+	Severity Severity // error findings block generating and saving the command
+	Message  string   // Human readable explanation
+	RuleID   string   // Stable identifier of the rule, e.g. ID-SID-LENGTH
+}
+
+// String renders the finding on a single line
+func (f Finding) String() string {
+	return fmt.Sprintf("%s [%s] %s: %s", f.Severity, f.RuleID, f.Field, f.Message)
+}
+
+// Validate runs every rule that applies to the configured operation.
+// Sections that the wizard skips (e.g. Advanced-only steps in Typical
+// mode) are not checked. A new database is also checked against the
+// resources of host, unless it is nil.
+func Validate(config *model.DBConfig, host *hostprobe.HostInfo) []Finding {
+	fs := append(OracleHome(config), validateOperation(config)...)
+	if config.Operation == model.OperationCreate {
+		fs = append(fs, Host(config, host)...)
+	}
+	return fs
+}
+
+// validateOperation runs the rules of the configured operation
+func validateOperation(config *model.DBConfig) []Finding {
+	switch config.Operation {
+	case model.OperationDelete:
+		return Delete(config)
+	case model.OperationCreatePDB:
+		return CreatePDB(config)
+	case model.OperationDeletePDB:
+		return DeletePDB(config)
+	case model.OperationUnplugPDB:
+		return UnplugPDB(config)
+	case model.OperationPlugPDB:
+		return PlugPDB(config)
+	case model.OperationRelocatePDB:
+		return RelocatePDB(config)
+	case model.OperationConfigure:
+		return ConfigureDatabase(config)
+	case model.OperationTemplate:
+		return CreateTemplate(config)
+	case model.OperationDuplicate:
+		return DuplicateDatabase(config)
+	case model.OperationAddInstance, model.OperationDeleteInstance:
+		return Instance(config)
+	case model.OperationCreate:
+	default:
+		return []Finding{{
+			Field:    "Operation",
+			Severity: SeverityError,
+			Message:  fmt.Sprintf("Unknown operation %q", config.Operation),
+			RuleID:   "OP-UNKNOWN",
+		}}
+	}
+
+	var findings []Finding
+	findings = append(findings, Release(config)...)
+	findings = append(findings, Template(config)...)
+	findings = append(findings, Identification(config)...)
+	findings = append(findings, Storage(config)...)
+	findings = append(findings, Recovery(config)...)
+	if config.CreationMode == model.CreationModeAdvanced {
+		findings = append(findings, Network(config)...)
+		findings = append(findings, DataVault(config)...)
+	}
+	findings = append(findings, Configuration(config)...)
+	findings = append(findings, InitParameters(config)...)
+	if config.CreationMode == model.CreationModeAdvanced {
+		findings = append(findings, Management(config)...)
+	}
+	findings = append(findings, Credentials(config)...)
+
+	return findings
+}
+
+// FirstError returns the first error finding, or nil if there is none
+func FirstError(findings []Finding) *Finding {
+	for i := range findings {
+		if findings[i].Severity == SeverityError {
+			return &findings[i]
+		}
+	}
+	return nil
+}
+
+// HasErrors returns true if any finding is an error
+func HasErrors(findings []Finding) bool {
+	return FirstError(findings) != nil
+}
+
+// passwordFields are the DBConfig fields holding passwords
+var passwordFields = map[string]bool{
+	"CommonPassword":                  true,
+	"SysPassword":                     true,
+	"SystemPassword":                  true,
+	"PDBAdminPassword":                true,
+	"DataVaultOwnerPassword":          true,
+	"DataVaultAccountManagerPassword": true,
+	"RemoteSysDBAPassword":            true,
+	"DBLinkPassword":                  true,
+}
+
+// WithoutSecrets downgrades the errors of missing passwords to warnings.
+// It applies to output that leaves the passwords out, such as a script
+// reading them at run time or a masked command.
+func WithoutSecrets(findings []Finding) []Finding {
+	result := make([]Finding, len(findings))
+	for i, f := range findings {
+		if f.Severity == SeverityError && passwordFields[f.Field] && strings.HasSuffix(f.RuleID, "-REQUIRED") {
+			f.Severity = SeverityWarning
+			f.Message += "; supply it when the command runs"
+		}
+		result[i] = f
+	}
+	return result
+}
+
+// Count returns the number of findings with the given severity
+func Count(findings []Finding, severity Severity) int {
+	n := 0
+	for _, f := range findings {
+		if f.Severity == severity {
+			n++
+		}
+	}
+	return n
+}
+
+// findings collects results for one section
+type findings []Finding
+
+func (fs *findings) add(severity Severity, ruleID, field, message string) {
+	*fs = append(*fs, Finding{
+		Field:    field,
+		Severity: severity,
+		Message:  message,
+		RuleID:   ruleID,
+	})
+}
+
+func (fs *findings) error(ruleID, field, message string) {
+	fs.add(SeverityError, ruleID, field, message)
+}
+
+func (fs *findings) warning(ruleID, field, message string) {
+	fs.add(SeverityWarning, ruleID, field, message)
+}
+
+func (fs *findings) info(ruleID, field, message string) {
+	fs.add(SeverityInfo, ruleID, field, message)
+}
@@ -0,0 +1,100 @@
+package validation
+
+import (
+	"slices"
+	"testing"
+
+	"dbca_tui/internal/hostprobe"
+	"dbca_tui/internal/model"
+)
+
+// ruleIDs returns the rule IDs of the findings with the given severity, in order
+func ruleIDs(findings []Finding, severity Severity) []string {
+	var ids []string
+	for _, f := range findings {
+		if f.Severity == severity {
+			ids = append(ids, f.RuleID)
+		}
+	}
+	return ids
+}
+
+func TestValidate(t *testing.T) {
+	host := &hostprobe.HostInfo{TotalMemoryMB: 1024}
+
+	tests := []struct {
+		name     string
+		edit     func(c *model.DBConfig)
+		host     *hostprobe.HostInfo
+		want     []string
+		wantHost bool // HOST-MEMORY-EXCEEDED is reported
+	}{
+		{
+			name: "valid create",
+			edit: func(c *model.DBConfig) {},
+		},
+		{
+			name:     "create checked against the host",
+			edit:     func(c *model.DBConfig) { c.TotalMemory = 4096 },
+			host:     host,
+			wantHost: true,
+		},
+		{
+			name: "other operations are not checked against the host",
+			edit: func(c *model.DBConfig) {
+				c.Operation = model.OperationDelete
+				c.DeleteSID = "sales"
+				c.TotalMemory = 4096
+			},
+			host: host,
+		},
+		{
+			name: "unknown operation",
+			edit: func(c *model.DBConfig) { c.Operation = "upgrade" },
+			want: []string{"OP-UNKNOWN"},
+		},
+	}
+
+	for _, tt := range tests {
+		config := model.NewDBConfig()
+		config.CommonPassword = "Kx7_pq2m"
+		config.SysPassword = "Kx7_pq2m"
+		config.SystemPassword = "Kx7_pq2m"
+		config.PDBAdminPassword = "Kx7_pq2m"
+		tt.edit(config)
+
+		findings := Validate(config, tt.host)
+		if got := ruleIDs(findings, SeverityError); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Validate() errors = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := slices.Contains(ruleIDs(findings, SeverityWarning), "HOST-MEMORY-EXCEEDED"); got != tt.wantHost {
+			t.Errorf("%s: Validate() reported HOST-MEMORY-EXCEEDED = %t, want %t", tt.name, got, tt.wantHost)
+		}
+	}
+}
+
+func TestWithoutSecrets(t *testing.T) {
+	findings := []Finding{
+		{Field: "SysPassword", Severity: SeverityError, RuleID: "PDB-SYS-REQUIRED", Message: "SYS password is required"},
+		{Field: "DBLinkPassword", Severity: SeverityError, RuleID: "REL-DBLINK-PASSWORD-REQUIRED"},
+		{Field: "SysPassword", Severity: SeverityError, RuleID: "CRED-SYS-POLICY"},
+		{Field: "SourceCDB", Severity: SeverityError, RuleID: "PDB-CDB-REQUIRED"},
+	}
+
+	got := WithoutSecrets(findings)
+	want := []Severity{SeverityWarning, SeverityWarning, SeverityError, SeverityError}
+	for i := range got {
+		if got[i].Severity != want[i] {
+			t.Errorf("WithoutSecrets()[%d] %s = %s, want %s", i, got[i].RuleID, got[i].Severity, want[i])
+		}
+	}
+	if got[0].Message != "SYS password is required; supply it when the command runs" {
+		t.Errorf("WithoutSecrets()[0].Message = %q", got[0].Message)
+	}
+	if findings[0].Severity != SeverityError {
+		t.Errorf("WithoutSecrets() changed the findings passed in")
+	}
+	if !HasErrors(got) || Count(got, SeverityWarning) != 2 {
+		t.Errorf("WithoutSecrets() = %v, want 2 warnings and 2 errors", got)
+	}
+}
@@ -22,10 +22,15 @@ type Wizard struct {
 
 // NewWizard creates a new wizard with the given steps
 func NewWizard(steps []Step) *Wizard {
+	return NewWizardWithConfig(steps, model.NewDBConfig())
+}
+
+// NewWizardWithConfig creates a new wizard whose steps start from the given config
+func NewWizardWithConfig(steps []Step, config *model.DBConfig) *Wizard {
 	return &Wizard{
 		steps:       steps,
 		currentStep: 0,
-		config:      model.NewDBConfig(),
+		config:      config,
 	}
 }
 
@@ -1,10 +1,17 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"dbca_tui/internal/generator"
+	"dbca_tui/internal/hostprobe"
+	"dbca_tui/internal/importer"
+	"dbca_tui/internal/model"
+	"dbca_tui/internal/profile"
 	"dbca_tui/internal/steps"
 	"dbca_tui/internal/wizard"
 
@@ -12,51 +19,159 @@ import (
 )
 
 func main() {
+	// Dispatch subcommands; anything else starts the interactive wizard
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "generate":
+			os.Exit(runGenerate(os.Args[2:]))
+		case "wizard":
+			os.Exit(runWizard(os.Args[2:]))
+		case "help":
+			printUsage()
+			os.Exit(0)
+		}
+	}
+	os.Exit(runWizard(os.Args[1:]))
+}
+
+// printUsage prints the top-level usage including the available subcommands
+func printUsage() {
+	fmt.Fprintln(os.Stderr, "Usage:")
+	fmt.Fprintln(os.Stderr, "  dbca_tui [wizard] [--from-rsp file | --profile file] [--oratab file]   Start the interactive wizard")
+	fmt.Fprintln(os.Stderr, "  dbca_tui generate --config file [--format cmd|rsp|script]   Generate output without the TUI")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Run 'dbca_tui <command> -h' for the options of a command.")
+}
+
+// runWizard starts the interactive bubbletea wizard
+func runWizard(args []string) int {
+	fs := flag.NewFlagSet("wizard", flag.ContinueOnError)
+	fromRsp := fs.String("from-rsp", "", "Prefill the wizard from an existing DBCA response file")
+	profilePath := fs.String("profile", "", "Prefill the wizard from a saved JSON or YAML profile")
+	oratab := fs.String("oratab", "", "Read local databases from this oratab file instead of /etc/oratab or /var/opt/oracle/oratab")
+	fs.Usage = func() {
+		printUsage()
+		fmt.Fprintln(os.Stderr, "\nWizard options:")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return 0
+		}
+		return 2
+	}
+
+	if *fromRsp != "" && *profilePath != "" {
+		fmt.Fprintln(os.Stderr, "Error: --from-rsp and --profile cannot be used together")
+		return 2
+	}
+
+	if *oratab != "" {
+		hostprobe.OratabPaths = []string{*oratab}
+	}
+
+	// Start from defaults unless a response file or profile was given
+	config := model.NewDBConfig()
+	if *profilePath != "" {
+		var err error
+		config, err = profile.Load(*profilePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading profile %s: %v\n", *profilePath, err)
+			return 1
+		}
+	}
+	if *fromRsp != "" {
+		var unknown []string
+		var err error
+		config, unknown, err = importer.LoadResponseFile(*fromRsp)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading response file %s: %v\n", *fromRsp, err)
+			return 1
+		}
+		reportUnknownKeys(unknown)
+	}
+	if (*profilePath != "" || *fromRsp != "") && config.TemplateDefaults == "" {
+		// The file already holds the settings of its template
+		config.TemplateDefaults = string(config.TemplateName)
+	}
+
 	// Create all wizard steps
 	wizardSteps := []wizard.Step{
-		steps.NewOperationStep(),       // Step 1: Create or Delete database
-		steps.NewCreationModeStep(),    // Step 2: Typical vs Advanced (Create only)
-		steps.NewDeploymentStep(),      // Step 3: Single/RAC/RAC One Node (Create only)
-		steps.NewTemplateStep(),        // Step 4: Template selection (Create only)
-		steps.NewIdentificationStep(),  // Step 5: DB name, SID, CDB/PDB (Create only)
-		steps.NewStorageStep(),         // Step 6: Storage configuration (Create only)
-		steps.NewRecoveryStep(),        // Step 7: FRA & Archive Log (Create only)
-		steps.NewNetworkStep(),         // Step 8: Listener (Create/Advanced only)
-		steps.NewDataVaultStep(),       // Step 9: Data Vault (Create/Advanced only)
-		steps.NewConfigStep(),          // Step 10: Memory, charset, etc. (Create only)
-		steps.NewManagementStep(),      // Step 11: EM config (Create/Advanced only)
-		steps.NewCredentialsStep(),     // Step 12: Passwords (Create only)
-		steps.NewDeleteStep(),          // Step 13: Delete configuration (Delete only)
-		steps.NewSummaryStep(),         // Step 14: Summary & command generation
+		steps.NewOperationStep(),      // Step 1: Operation to generate
+		steps.NewOracleHomeStep(),     // Step 2: Oracle home running dbca
+		steps.NewVersionStep(),        // Step 3: Target Oracle release (all but Delete)
+		steps.NewConfigureDBStep(),    // Step 4: Database to configure (Configure only)
+		steps.NewCreateTemplateStep(), // Step 5: Template source database (Create Template only)
+		steps.NewDuplicateStep(),      // Step 6: Primary and duplicate or standby names (Duplicate only)
+		steps.NewCreationModeStep(),   // Step 7: Typical vs Advanced (Create only)
+		steps.NewDeploymentStep(),     // Step 8: Single/RAC/RAC One Node (Create only)
+		steps.NewTemplateStep(),       // Step 9: Template selection (Create only)
+		steps.NewIdentificationStep(), // Step 10: DB name, SID, CDB/PDB (Create only)
+		steps.NewStorageStep(),        // Step 11: Storage configuration (Create, Duplicate)
+		steps.NewRecoveryStep(),       // Step 12: FRA & Archive Log (Create/Configure)
+		steps.NewNetworkStep(),        // Step 13: Listener (Create/Advanced, Duplicate)
+		steps.NewDataVaultStep(),      // Step 14: Data Vault & Label Security (Create/Advanced, Configure)
+		steps.NewConfigStep(),         // Step 15: Memory, charset, etc. (Create only)
+		steps.NewInitParamsStep(),     // Step 16: Init parameters (Create/Advanced only)
+		steps.NewManagementStep(),     // Step 17: EM config (Create/Advanced, Configure)
+		steps.NewCredentialsStep(),    // Step 18: Passwords (Create only)
+		steps.NewDeleteStep(),         // Step 19: Delete configuration (Delete only)
+		steps.NewInstanceStep(),       // Step 20: RAC instance to add or delete (Add/Delete Instance only)
+		steps.NewCreatePDBStep(),      // Step 21: PDB configuration (Create PDB only)
+		steps.NewDeletePDBStep(),      // Step 22: PDB deletion (Delete PDB only)
+		steps.NewUnplugPDBStep(),      // Step 23: PDB unplug (Unplug PDB only)
+		steps.NewPlugPDBStep(),        // Step 24: PDB plug-in (Plug PDB only)
+		steps.NewRelocatePDBStep(),    // Step 25: PDB relocation (Relocate PDB only)
+		steps.NewSummaryStep(),        // Step 26: Summary & command generation
 	}
 
 	// Create the wizard
-	w := wizard.NewWizard(wizardSteps)
+	w := wizard.NewWizardWithConfig(wizardSteps, config)
 
 	// Create the bubbletea program
 	p := tea.NewProgram(w, tea.WithAltScreen())
 
 	// Run the program
-	model, err := p.Run()
+	result, err := p.Run()
 	if err != nil {
 		fmt.Printf("Error running program: %v\n", err)
-		os.Exit(1)
+		return 1
 	}
 
 	// Check if we should print the command
-	if wiz, ok := model.(*wizard.Wizard); ok {
+	if wiz, ok := result.(*wizard.Wizard); ok {
 		if wiz.ShouldPrintCommand() {
 			config := wiz.GetConfig()
 			fmt.Println()
-			if config.Operation == "delete" {
-				fmt.Println("# DBCA Silent Mode Command - Delete Database")
-			} else {
-				fmt.Println("# DBCA Silent Mode Command - Create Database")
-			}
+			fmt.Printf("# DBCA Silent Mode Command - %s\n", generator.OperationTitle(config))
 			fmt.Println("# Generated by DBCA TUI")
 			fmt.Println()
 			fmt.Println(generator.GenerateCommandWithPasswords(config))
 			fmt.Println()
 		}
 	}
+
+	return 0
+}
+
+// loadConfigFile loads a DBConfig from a response file or profile,
+// choosing the reader from the file extension
+func loadConfigFile(path string) (*model.DBConfig, error) {
+	if strings.ToLower(filepath.Ext(path)) == ".rsp" {
+		config, unknown, err := importer.LoadResponseFile(path)
+		if err != nil {
+			return nil, err
+		}
+		reportUnknownKeys(unknown)
+		return config, nil
+	}
+	return profile.Load(path)
+}
+
+// reportUnknownKeys tells the user which response file keys are only carried through
+func reportUnknownKeys(unknown []string) {
+	if len(unknown) > 0 {
+		fmt.Fprintf(os.Stderr, "Preserving %d unrecognized response file key(s): %s\n",
+			len(unknown), strings.Join(unknown, ", "))
+	}
 }